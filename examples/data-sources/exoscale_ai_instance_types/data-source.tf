@@ -0,0 +1,7 @@
+data "exoscale_ai_instance_types" "gpus" {
+  zone = "at-vie-2"
+}
+
+output "my_authorized_gpu_types" {
+  value = [for t in data.exoscale_ai_instance_types.gpus.instance_types : t.family if t.authorized]
+}
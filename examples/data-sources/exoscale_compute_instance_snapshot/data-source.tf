@@ -0,0 +1,10 @@
+# Most recent snapshot of an instance taken since the beginning of the year.
+data "exoscale_compute_instance_snapshot" "my_snapshot" {
+  zone          = "ch-gva-2"
+  instance_id   = exoscale_compute_instance.my_instance.id
+  created_after = "2025-01-01T00:00:00Z"
+}
+
+output "my_snapshot_id" {
+  value = data.exoscale_compute_instance_snapshot.my_snapshot.id
+}
@@ -0,0 +1,9 @@
+data "exoscale_iam_users" "all" {}
+
+# List the users without two-factor authentication, e.g. for access reviews.
+output "users_without_2fa" {
+  value = [
+    for user in data.exoscale_iam_users.all.users :
+    user.email if !user.two_factor_authentication
+  ]
+}
@@ -0,0 +1,8 @@
+data "exoscale_kms_key" "my_key" {
+  zone = "ch-gva-2"
+  name = "my-key"
+}
+
+output "my_key_id" {
+  value = data.exoscale_kms_key.my_key.id
+}
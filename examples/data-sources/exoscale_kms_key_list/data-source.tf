@@ -0,0 +1,7 @@
+data "exoscale_kms_key_list" "my_keys" {
+  zone = "ch-gva-2"
+}
+
+output "my_enabled_key_names" {
+  value = [for key in data.exoscale_kms_key_list.my_keys.keys : key.name if key.status == "enabled"]
+}
@@ -0,0 +1,8 @@
+data "exoscale_sos_bucket" "my_bucket" {
+  bucket = "my-bucket"
+  zone   = "ch-gva-2"
+}
+
+output "my_bucket_url" {
+  value = data.exoscale_sos_bucket.my_bucket.url
+}
@@ -0,0 +1,15 @@
+data "exoscale_sos_buckets_usage" "my_buckets" {
+  zone = "ch-gva-2"
+  name = "/^my-app-.*/"
+}
+
+# Fail the plan when a bucket exceeds its budget (100 GiB).
+check "sos_buckets_budget" {
+  assert {
+    condition = alltrue([
+      for bucket in data.exoscale_sos_buckets_usage.my_buckets.buckets :
+      bucket.size <= 100 * 1024 * 1024 * 1024
+    ])
+    error_message = "An SOS bucket exceeds its 100 GiB budget."
+  }
+}
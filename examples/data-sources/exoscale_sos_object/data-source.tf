@@ -0,0 +1,9 @@
+data "exoscale_sos_object" "my_script" {
+  bucket = "my-bucket"
+  zone   = "ch-gva-2"
+  key    = "scripts/bootstrap.sh"
+}
+
+output "my_script_content" {
+  value = data.exoscale_sos_object.my_script.body
+}
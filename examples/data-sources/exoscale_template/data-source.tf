@@ -6,3 +6,10 @@ data "exoscale_template" "my_template" {
 output "my_template_id" {
   value = data.exoscale_template.my_template.id
 }
+
+# Custom templates private to the organization.
+data "exoscale_template" "my_private_template" {
+  zone       = "ch-gva-2"
+  name       = "my-template"
+  visibility = "private"
+}
@@ -0,0 +1,9 @@
+data "exoscale_vpc_route" "my_route" {
+  zone   = "ch-gva-2"
+  vpc_id = data.exoscale_vpc.my_vpc.id
+  id     = "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"
+}
+
+output "my_route_target" {
+  value = data.exoscale_vpc_route.my_route.target
+}
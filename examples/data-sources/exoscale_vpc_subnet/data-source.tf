@@ -0,0 +1,9 @@
+data "exoscale_vpc_subnet" "my_subnet" {
+  zone   = "ch-gva-2"
+  vpc_id = data.exoscale_vpc.my_vpc.id
+  name   = "my-subnet"
+}
+
+output "my_subnet_ipv4_block" {
+  value = data.exoscale_vpc_subnet.my_subnet.ipv4_block
+}
@@ -0,0 +1,14 @@
+ephemeral "exoscale_compute_instance_password" "my_instance" {
+  zone        = exoscale_compute_instance.my_instance.zone
+  instance_id = exoscale_compute_instance.my_instance.id
+}
+
+# Push the password to a secret manager without persisting it in the state.
+resource "vault_kv_secret_v2" "my_instance" {
+  mount = "secret"
+  name  = "instances/my-instance"
+  data_json_wo = jsonencode({
+    password = ephemeral.exoscale_compute_instance_password.my_instance.password
+  })
+  data_json_wo_version = 1
+}
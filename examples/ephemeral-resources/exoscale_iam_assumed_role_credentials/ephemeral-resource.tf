@@ -0,0 +1,11 @@
+ephemeral "exoscale_iam_assumed_role_credentials" "my_workload" {
+  role_id = exoscale_iam_role.my_workload.id
+  ttl     = 3600
+}
+
+# Configure another provider with the temporary credentials.
+provider "exoscale" {
+  alias  = "my_workload"
+  key    = ephemeral.exoscale_iam_assumed_role_credentials.my_workload.key
+  secret = ephemeral.exoscale_iam_assumed_role_credentials.my_workload.secret
+}
@@ -0,0 +1,23 @@
+ephemeral "exoscale_sos_presigned_url" "my_artefact" {
+  bucket     = "my-bucket"
+  zone       = "ch-gva-2"
+  key        = "artefacts/app.tar.gz"
+  expires_in = "10m"
+}
+
+# Fetch the artefact on the instance without persisting the URL in the state.
+resource "terraform_data" "my_artefact" {
+  triggers_replace = [exoscale_compute_instance.my_instance.id]
+
+  connection {
+    type = "ssh"
+    host = exoscale_compute_instance.my_instance.public_ip_address
+    user = "ubuntu"
+  }
+
+  provisioner "remote-exec" {
+    inline = [
+      "curl -fsSL -o /tmp/app.tar.gz '${ephemeral.exoscale_sos_presigned_url.my_artefact.url}'",
+    ]
+  }
+}
@@ -0,0 +1,5 @@
+# An existing AI API key may be imported by `<ID>@<zone>`:
+
+terraform import \
+  exoscale_ai_api_key.my_api_key \
+  f81d4fae-7dec-11d0-a765-00a0c91e6bf6@at-vie-2
@@ -0,0 +1,8 @@
+resource "exoscale_ai_api_key" "my_api_key" {
+  zone  = "at-vie-2"
+  name  = "my-api-key"
+  scope = exoscale_ai_deployment.my_deployment.id
+
+  # Change this value to rotate the key secret.
+  rotation_trigger = "2025-01"
+}
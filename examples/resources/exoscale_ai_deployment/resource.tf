@@ -0,0 +1,19 @@
+resource "exoscale_ai_model" "my_model" {
+  zone = "at-vie-2"
+  name = "Qwen/Qwen2.5-0.5B-Instruct"
+}
+
+resource "exoscale_ai_deployment" "my_deployment" {
+  zone      = "at-vie-2"
+  name      = "my-deployment"
+  model_id  = exoscale_ai_model.my_model.id
+  gpu_type  = "gpua5000"
+  gpu_count = 1
+  replicas  = 1
+
+  inference_engine_parameters = ["--max-model-len=8192"]
+}
+
+output "my_deployment_url" {
+  value = exoscale_ai_deployment.my_deployment.deployment_url
+}
@@ -0,0 +1,5 @@
+# An existing AI model may be imported by `<ID>@<zone>`:
+
+terraform import \
+  exoscale_ai_model.my_model \
+  f81d4fae-7dec-11d0-a765-00a0c91e6bf6@at-vie-2
@@ -0,0 +1,4 @@
+resource "exoscale_ai_model" "my_model" {
+  zone = "at-vie-2"
+  name = "Qwen/Qwen2.5-0.5B-Instruct"
+}
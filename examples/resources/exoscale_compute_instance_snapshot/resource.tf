@@ -0,0 +1,8 @@
+resource "exoscale_compute_instance_snapshot" "my_snapshot" {
+  zone        = "ch-gva-2"
+  instance_id = exoscale_compute_instance.my_instance.id
+
+  timeouts {
+    create = "30m"
+  }
+}
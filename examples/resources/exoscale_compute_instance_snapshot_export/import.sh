@@ -0,0 +1,5 @@
+# An existing compute instance snapshot export may be imported by `<snapshot-ID>@<zone>`:
+
+terraform import \
+  exoscale_compute_instance_snapshot_export.my_export \
+  f81d4fae-7dec-11d0-a765-00a0c91e6bf6@ch-gva-2
@@ -0,0 +1,18 @@
+resource "exoscale_compute_instance_snapshot" "my_snapshot" {
+  zone        = "ch-gva-2"
+  instance_id = exoscale_compute_instance.my_instance.id
+}
+
+resource "exoscale_compute_instance_snapshot_export" "my_export" {
+  zone        = exoscale_compute_instance_snapshot.my_snapshot.zone
+  snapshot_id = exoscale_compute_instance_snapshot.my_snapshot.id
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+output "my_export_url" {
+  value     = exoscale_compute_instance_snapshot_export.my_export.url
+  sensitive = true
+}
@@ -0,0 +1,5 @@
+# An existing IAM user may be imported by `<ID>`:
+
+terraform import \
+  exoscale_iam_user.jane \
+  f81d4fae-7dec-11d0-a765-00a0c91e6bf6
@@ -0,0 +1,18 @@
+resource "exoscale_iam_role" "developer" {
+  name     = "developer"
+  editable = true
+
+  policy = {
+    default_service_strategy = "deny"
+    services = {
+      compute = {
+        type = "allow"
+      }
+    }
+  }
+}
+
+resource "exoscale_iam_user" "jane" {
+  email   = "jane@example.com"
+  role_id = exoscale_iam_role.developer.id
+}
@@ -0,0 +1,15 @@
+resource "exoscale_kms_key" "my_key" {
+  zone          = "ch-gva-2"
+  name          = "my-key"
+  description   = "Application secrets encryption key"
+  multi_zone    = true
+  replica_zones = ["ch-dk-2"]
+
+  rotation {
+    enabled     = true
+    period_days = 365
+  }
+
+  # Change this value to rotate the key material immediately.
+  rotation_trigger = "2025-01"
+}
@@ -0,0 +1,5 @@
+# An existing private network attachment may be imported by `<network-ID>/<instance-ID>@<zone>`:
+
+terraform import \
+  exoscale_private_network_attachment.my_attachment \
+  f81d4fae-7dec-11d0-a765-00a0c91e6bf6/9ecc6b8b-73d4-4211-8ced-f7f29bb79524@ch-gva-2
@@ -0,0 +1,6 @@
+resource "exoscale_private_network_attachment" "my_attachment" {
+  zone        = exoscale_private_network.my_private_network.zone
+  network_id  = exoscale_private_network.my_private_network.id
+  instance_id = exoscale_compute_instance.my_instance.id
+  ip_address  = "10.0.0.100"
+}
@@ -0,0 +1,5 @@
+# An existing SOS bucket may be imported by `<bucket>@<zone>`:
+
+terraform import \
+  exoscale_sos_bucket.my_bucket \
+  my-bucket@ch-gva-2
@@ -0,0 +1,8 @@
+resource "exoscale_sos_bucket" "my_bucket" {
+  bucket = "my-bucket"
+  zone   = "ch-gva-2"
+  acl    = "private"
+
+  # Delete all the objects of the bucket on destroy.
+  force_destroy = true
+}
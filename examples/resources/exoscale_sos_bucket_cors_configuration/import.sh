@@ -0,0 +1,5 @@
+# An existing SOS bucket CORS configuration may be imported by `<bucket>@<zone>`:
+
+terraform import \
+  exoscale_sos_bucket_cors_configuration.my_bucket_cors_configuration \
+  my-bucket@ch-gva-2
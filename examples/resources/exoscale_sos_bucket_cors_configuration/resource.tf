@@ -0,0 +1,18 @@
+resource "exoscale_sos_bucket" "my_bucket" {
+  bucket = "my-bucket"
+  zone   = "ch-gva-2"
+}
+
+resource "exoscale_sos_bucket_cors_configuration" "my_bucket_cors_configuration" {
+  bucket = exoscale_sos_bucket.my_bucket.bucket
+  zone   = exoscale_sos_bucket.my_bucket.zone
+
+  cors_rule = [
+    {
+      allowed_methods = ["GET", "HEAD"]
+      allowed_origins = ["https://www.example.net"]
+      expose_headers  = ["ETag"]
+      max_age_seconds = 3000
+    },
+  ]
+}
@@ -0,0 +1,5 @@
+# An existing SOS bucket lifecycle configuration may be imported by `<bucket>@<zone>`:
+
+terraform import \
+  exoscale_sos_bucket_lifecycle_configuration.my_bucket_lifecycle_configuration \
+  my-bucket@ch-gva-2
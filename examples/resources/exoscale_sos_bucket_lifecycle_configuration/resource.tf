@@ -0,0 +1,24 @@
+resource "exoscale_sos_bucket" "my_bucket" {
+  bucket = "my-bucket"
+  zone   = "ch-gva-2"
+}
+
+resource "exoscale_sos_bucket_lifecycle_configuration" "my_bucket_lifecycle_configuration" {
+  bucket = exoscale_sos_bucket.my_bucket.bucket
+  zone   = exoscale_sos_bucket.my_bucket.zone
+
+  rule = [
+    {
+      id              = "expire-logs"
+      status          = "Enabled"
+      prefix          = "logs/"
+      expiration_days = 30
+    },
+    {
+      id                                     = "cleanup"
+      status                                 = "Enabled"
+      noncurrent_version_expiration_days     = 7
+      abort_incomplete_multipart_upload_days = 1
+    },
+  ]
+}
@@ -0,0 +1,5 @@
+# An existing SOS bucket versioning may be imported by `<bucket>@<zone>`:
+
+terraform import \
+  exoscale_sos_bucket_versioning.my_bucket_versioning \
+  my-bucket@ch-gva-2
@@ -0,0 +1,10 @@
+resource "exoscale_sos_bucket" "my_bucket" {
+  bucket = "my-bucket"
+  zone   = "ch-gva-2"
+}
+
+resource "exoscale_sos_bucket_versioning" "my_bucket_versioning" {
+  bucket = exoscale_sos_bucket.my_bucket.bucket
+  zone   = exoscale_sos_bucket.my_bucket.zone
+  status = "Enabled"
+}
@@ -0,0 +1,5 @@
+# An existing SOS object may be imported by `<bucket>/<key>@<zone>`:
+
+terraform import \
+  exoscale_sos_object.my_script \
+  my-bucket/scripts/bootstrap.sh@ch-gva-2
@@ -0,0 +1,16 @@
+resource "exoscale_sos_bucket" "my_bucket" {
+  bucket = "my-bucket"
+  zone   = "ch-gva-2"
+}
+
+resource "exoscale_sos_object" "my_script" {
+  bucket       = exoscale_sos_bucket.my_bucket.bucket
+  zone         = exoscale_sos_bucket.my_bucket.zone
+  key          = "scripts/bootstrap.sh"
+  source       = "${path.module}/bootstrap.sh"
+  content_type = "text/x-shellscript"
+
+  metadata = {
+    owner = "ops"
+  }
+}
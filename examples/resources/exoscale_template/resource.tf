@@ -0,0 +1,19 @@
+# Register a template from a disk image.
+resource "exoscale_template" "my_template" {
+  zone         = "ch-gva-2"
+  name         = "my-template"
+  url          = "https://example.net/my-image.qcow2"
+  checksum     = "0f0a6ad29bb7a1b5dc6eaa3e12ab6f0c"
+  boot_mode    = "uefi"
+  default_user = "ubuntu"
+
+  replica_zones = ["ch-dk-2", "de-fra-1"]
+}
+
+# Promote an instance snapshot to a template.
+resource "exoscale_template" "my_golden_image" {
+  zone         = "ch-gva-2"
+  name         = "my-golden-image"
+  snapshot_id  = exoscale_compute_instance_snapshot.my_snapshot.id
+  default_user = "ubuntu"
+}
@@ -0,0 +1,5 @@
+resource "exoscale_vpc" "my_vpc" {
+  zone        = "ch-gva-2"
+  name        = "my-vpc"
+  description = "My VPC"
+}
@@ -0,0 +1,7 @@
+resource "exoscale_vpc_route" "my_route" {
+  zone        = exoscale_vpc_subnet.my_subnet.zone
+  vpc_id      = exoscale_vpc_subnet.my_subnet.vpc_id
+  subnet_id   = exoscale_vpc_subnet.my_subnet.id
+  destination = "0.0.0.0/0"
+  target      = "10.0.0.1"
+}
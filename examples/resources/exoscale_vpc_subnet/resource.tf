@@ -0,0 +1,11 @@
+resource "exoscale_vpc" "my_vpc" {
+  zone = "ch-gva-2"
+  name = "my-vpc"
+}
+
+resource "exoscale_vpc_subnet" "my_subnet" {
+  zone       = exoscale_vpc.my_vpc.zone
+  vpc_id     = exoscale_vpc.my_vpc.id
+  name       = "my-subnet"
+  ipv4_block = "10.0.0.0/24"
+}
@@ -0,0 +1,6 @@
+resource "exoscale_vpc_subnet_attachment" "my_attachment" {
+  zone        = exoscale_vpc_subnet.my_subnet.zone
+  vpc_id      = exoscale_vpc_subnet.my_subnet.vpc_id
+  subnet_id   = exoscale_vpc_subnet.my_subnet.id
+  instance_id = exoscale_compute_instance.my_instance.id
+}
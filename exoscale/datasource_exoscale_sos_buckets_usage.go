@@ -0,0 +1,112 @@
+package exoscale
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/general"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/list"
+)
+
+const (
+	dsSOSBucketsUsageIdentifier = "exoscale_sos_buckets_usage"
+	dsSOSBucketsUsageBuckets    = "buckets"
+
+	dsSOSBucketUsageAttrCreatedAt = "created_at"
+	dsSOSBucketUsageAttrName      = "name"
+	dsSOSBucketUsageAttrSize      = "size"
+	dsSOSBucketUsageAttrZone      = "zone"
+)
+
+func dataSourceSOSBucketsUsageGetElementScheme() general.SchemaMap {
+	return general.SchemaMap{
+		dsSOSBucketUsageAttrCreatedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The bucket creation date.",
+		},
+		dsSOSBucketUsageAttrName: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The bucket name.",
+		},
+		dsSOSBucketUsageAttrSize: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The bucket size (bytes).",
+		},
+		dsSOSBucketUsageAttrZone: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+		},
+	}
+}
+
+func dataSourceSOSBucketsUsage() *schema.Resource {
+	ret := list.FilterableListDataSource(dsSOSBucketsUsageIdentifier, dsSOSBucketsUsageBuckets, dsSOSBucketUsageAttrZone, getSOSBucketsUsageList, sosBucketUsageToDataMap, generateSOSBucketsUsageListID, dataSourceSOSBucketsUsageGetElementScheme)
+	ret.Description = `List the usage of Exoscale [SOS](https://community.exoscale.com/product/storage/object-storage/) Buckets in a zone.
+
+The usage is reported by the Exoscale API and may lag behind the actual content of the buckets.
+
+Corresponding resource: [exoscale_sos_bucket](../resources/sos_bucket.md).`
+
+	return ret
+}
+
+func sosBucketUsageToDataMap(bucket *v3.SOSBucketUsage) general.TerraformObject {
+	ret := make(general.TerraformObject)
+
+	ret[dsSOSBucketUsageAttrCreatedAt] = bucket.CreatedAT.Format(time.RFC3339)
+	ret[dsSOSBucketUsageAttrName] = bucket.Name
+	ret[dsSOSBucketUsageAttrSize] = bucket.Size
+
+	return ret
+}
+
+func generateSOSBucketsUsageListID(buckets []*v3.SOSBucketUsage) string {
+	names := make([]string, 0, len(buckets))
+
+	for _, bucket := range buckets {
+		names = append(names, bucket.Name)
+	}
+
+	sort.Strings(names)
+
+	return fmt.Sprintf("%x", md5.Sum([]byte(strings.Join(names, ""))))
+}
+
+func getSOSBucketsUsageList(ctx context.Context, d *schema.ResourceData, meta any) ([]*v3.SOSBucketUsage, error) {
+	zone := d.Get(dsSOSBucketUsageAttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	client, err := config.GetClientV3WithZone(ctx, meta, zone)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client for zone %q: %s", zone, err)
+	}
+
+	resp, err := client.ListSOSBucketsUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting SOS buckets usage: %s", err)
+	}
+
+	// The usage is reported for the buckets of all zones.
+	buckets := make([]*v3.SOSBucketUsage, 0, len(resp.SOSBucketsUsage))
+	for i := range resp.SOSBucketsUsage {
+		if string(resp.SOSBucketsUsage[i].ZoneName) == zone {
+			buckets = append(buckets, &resp.SOSBucketsUsage[i])
+		}
+	}
+
+	return buckets, nil
+}
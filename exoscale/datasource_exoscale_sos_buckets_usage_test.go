@@ -0,0 +1,43 @@
+package exoscale
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSOSBucketsUsage(t *testing.T) {
+	t.Parallel()
+
+	dsName := "data." + dsSOSBucketsUsageIdentifier + ".test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(`data %q "test" {}`, dsSOSBucketsUsageIdentifier),
+				ExpectError: regexp.MustCompile("Missing required argument"),
+			},
+			{
+				Config: fmt.Sprintf(`
+data %q "test" {
+  zone = %q
+}
+`, dsSOSBucketsUsageIdentifier, testZoneName),
+				Check: resource.TestCheckResourceAttrSet(dsName, "buckets.#"),
+			},
+			{
+				Config: fmt.Sprintf(`
+data %q "test" {
+  zone = %q
+  name = "/^terraform-provider-test-nonexistent-.*/"
+}
+`, dsSOSBucketsUsageIdentifier, testZoneName),
+				Check: resource.TestCheckResourceAttr(dsName, "buckets.#", "0"),
+			},
+		},
+	})
+}
@@ -16,18 +16,29 @@ import (
 )
 
 const (
-	dsTemplateAttrDefaultUser = "default_user"
-	dsTemplateAttrID          = "id"
-	dsTemplateAttrName        = "name"
-	dsTemplateAttrVisibility  = "visibility"
-	dsTemplateAttrZone        = "zone"
+	dsTemplateAttrBootMode        = "boot_mode"
+	dsTemplateAttrBuild           = "build"
+	dsTemplateAttrChecksum        = "checksum"
+	dsTemplateAttrDefaultUser     = "default_user"
+	dsTemplateAttrDescription     = "description"
+	dsTemplateAttrID              = "id"
+	dsTemplateAttrMaintainer      = "maintainer"
+	dsTemplateAttrName            = "name"
+	dsTemplateAttrPasswordEnabled = "password_enabled"
+	dsTemplateAttrSize            = "size"
+	dsTemplateAttrSSHKeyEnabled   = "ssh_key_enabled"
+	dsTemplateAttrVersion         = "version"
+	dsTemplateAttrVisibility      = "visibility"
+	dsTemplateAttrZone            = "zone"
 )
 
 func dataSourceTemplate() *schema.Resource {
 	return &schema.Resource{
 		Description: `Fetch Exoscale [Compute Instance Templates](https://community.exoscale.com/product/compute/instances/how-to/custom-templates/) data.
 
-Exoscale instance templates are regularly updated to include the latest updates. Whenever this happens, the template ID also changes which can lead terraform to plan the recreation of an instance. To work around this you may find [this issue](https://github.com/exoscale/terraform-provider-exoscale/issues/366) helpful.`,
+Exoscale instance templates are regularly updated to include the latest updates. Whenever this happens, the template ID also changes which can lead terraform to plan the recreation of an instance. To work around this you may find [this issue](https://github.com/exoscale/terraform-provider-exoscale/issues/366) helpful.
+
+Corresponding resource: [exoscale_template](../resources/template.md).`,
 		Schema: map[string]*schema.Schema{
 			dsTemplateAttrZone: {
 				Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
@@ -59,6 +70,51 @@ Exoscale instance templates are regularly updated to include the latest updates.
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			dsTemplateAttrBootMode: {
+				Description: "The template boot mode (`legacy` or `uefi`).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			dsTemplateAttrBuild: {
+				Description: "The template build.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			dsTemplateAttrChecksum: {
+				Description: "The template MD5 checksum.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			dsTemplateAttrDescription: {
+				Description: "The template description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			dsTemplateAttrMaintainer: {
+				Description: "The template maintainer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			dsTemplateAttrPasswordEnabled: {
+				Description: "Whether password-based login is enabled.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			dsTemplateAttrSize: {
+				Description: "The template size (bytes).",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			dsTemplateAttrSSHKeyEnabled: {
+				Description: "Whether SSH key-based login is enabled.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			dsTemplateAttrVersion: {
+				Description: "The template version.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 
 		ReadContext: dataSourceTemplateRead,
@@ -112,6 +168,33 @@ func dataSourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta an
 	if err := d.Set(dsTemplateAttrDefaultUser, defaultString(template.DefaultUser, "")); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set(dsTemplateAttrBootMode, defaultString(template.BootMode, "")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dsTemplateAttrBuild, defaultString(template.Build, "")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dsTemplateAttrChecksum, defaultString(template.Checksum, "")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dsTemplateAttrDescription, defaultString(template.Description, "")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dsTemplateAttrMaintainer, defaultString(template.Maintainer, "")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dsTemplateAttrPasswordEnabled, defaultBool(template.PasswordEnabled, false)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dsTemplateAttrSize, defaultInt64(template.Size, 0)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dsTemplateAttrSSHKeyEnabled, defaultBool(template.SSHKeyEnabled, false)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(dsTemplateAttrVersion, defaultString(template.Version, "")); err != nil {
+		return diag.FromErr(err)
+	}
 
 	tflog.Debug(ctx, "read finished successfully", map[string]any{
 		"id": general.ResourceIDString(d, "exoscale_template"),
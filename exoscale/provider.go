@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/anti_affinity_group"
@@ -76,6 +77,29 @@ func Provider() *schema.Provider {
 					"Timeout in seconds for waiting on compute resources to become available (by default: %.0f)",
 					config.DefaultTimeout.Seconds()),
 			},
+			"assume_role": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Assume an IAM role: the provider uses the temporary credentials of the role, requested with the configured API key, instead of the key itself",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the IAM role to assume",
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Description: fmt.Sprintf(
+								"Lifetime in seconds of the temporary credentials, within the maximum allowed by the role (by default: %d)",
+								providerConfig.DefaultAssumeRoleTTL),
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -93,6 +117,7 @@ func Provider() *schema.Provider {
 			dsSKSClustersListIdentifier:      dataSourceSKSClusterList(),
 			dsSKSNodepoolsListIdentifier:     dataSourceSKSNodepoolList(),
 			dsSKSNodepoolIdentifier:          dataSourceSKSNodepool(),
+			dsSOSBucketsUsageIdentifier:      dataSourceSOSBucketsUsage(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -135,7 +160,7 @@ func CreateClient(baseConfig *providerConfig.BaseConfig) (*exov2.Client, error)
 		}()))
 }
 
-func ProviderConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// we only need to set UserAgent once, so lets do it right away.
@@ -207,12 +232,37 @@ func ProviderConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Dia
 		}
 	}
 
+	opts := []exov3.ClientOpt{}
+	if ep := os.Getenv("EXOSCALE_API_ENDPOINT"); ep != "" {
+		opts = append(opts, exov3.ClientOptWithEndpoint(exov3.Endpoint(ep)), exov3.ClientOptWithUserAgent(UserAgent))
+	}
+
+	var keyExpiresAt time.Time
+	if v, ok := d.GetOk("assume_role.0.role_id"); ok {
+		ttl := int64(providerConfig.DefaultAssumeRoleTTL)
+		if v, ok := d.GetOk("assume_role.0.ttl"); ok {
+			ttl = int64(v.(int))
+		}
+
+		bootstrapClient, err := exov3.NewClient(credentials.NewStaticCredentials(key.(string), secret.(string)), opts...)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		key, secret, keyExpiresAt, err = providerConfig.AssumeRole(ctx, bootstrapClient, key.(string), v.(string), ttl)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
 	baseConfig := providerConfig.BaseConfig{
 		Key:         key.(string),
 		Secret:      secret.(string),
 		Timeout:     ConvertTimeout(timeout),
 		Environment: environment.(string),
 		SOSEndpoint: sosEndpoint.(string),
+
+		KeyExpiresAt: keyExpiresAt,
 	}
 
 	clv2, err := CreateClient(&baseConfig)
@@ -226,11 +276,6 @@ func ProviderConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Dia
 		secret.(string),
 	)
 
-	opts := []exov3.ClientOpt{}
-	if ep := os.Getenv("EXOSCALE_API_ENDPOINT"); ep != "" {
-		opts = append(opts, exov3.ClientOptWithEndpoint(exov3.Endpoint(ep)), exov3.ClientOptWithUserAgent(UserAgent))
-	}
-
 	clv3, err := exov3.NewClient(creds, opts...)
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -68,6 +68,44 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// TestProviderSchemaMux ensures the SDK and framework halves of the provider
+// expose the same provider schema, as required by the mux server.
+func TestProviderSchemaMux(t *testing.T) {
+	t.Parallel()
+
+	server, err := TestAccProtoV6ProviderFactories["exoscale"]()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+func TestProviderAssumeRoleTTL(t *testing.T) {
+	t.Parallel()
+
+	validate := Provider().Schema["assume_role"].Elem.(*schema.Resource).Schema["ttl"].ValidateFunc
+
+	for _, ttl := range []int{-1, 0} {
+		if _, errs := validate(ttl, "ttl"); len(errs) == 0 {
+			t.Errorf("expected ttl %d to be rejected", ttl)
+		}
+	}
+
+	if _, errs := validate(1800, "ttl"); len(errs) > 0 {
+		t.Errorf("expected ttl 1800 to be accepted, got %v", errs)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	key := os.Getenv("EXOSCALE_API_KEY")
 	secret := os.Getenv("EXOSCALE_API_SECRET")
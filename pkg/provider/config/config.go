@@ -1,8 +1,11 @@
 package config
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	exov2 "github.com/exoscale/egoscale/v2"
@@ -18,6 +21,9 @@ type BaseConfig struct {
 	Timeout     time.Duration
 	Environment string
 	SOSEndpoint string
+
+	// KeyExpiresAt is the expiration date of temporary credentials (assume_role), zero otherwise.
+	KeyExpiresAt time.Time
 }
 
 type ExoscaleProviderConfig struct {
@@ -28,6 +34,61 @@ type ExoscaleProviderConfig struct {
 	SOSEndpoint string
 }
 
+// DefaultAssumeRoleTTL is the lifetime of the credentials obtained by assuming an IAM role (seconds).
+const DefaultAssumeRoleTTL = 3600
+
+// maxAssumeRoleRefreshMargin is how long before their expiry cached assumed role
+// credentials are renewed, so that they remain valid for the rest of the run.
+const maxAssumeRoleRefreshMargin = 5 * time.Minute
+
+// assumedRoles caches the assumed role credentials, so that both halves of the
+// muxed provider share the same temporary API key.
+var (
+	assumedRoles   = map[string]*exov3.AssumeIAMRoleResponse{}
+	assumedRolesMu sync.Mutex
+)
+
+// AssumeRole returns the temporary key and secret of the IAM role roleID and their expiration date,
+// requested for ttl seconds with client, authenticated with the API key key.
+func AssumeRole(ctx context.Context, client *exov3.Client, key, roleID string, ttl int64) (string, string, time.Time, error) {
+	id, err := exov3.ParseUUID(roleID)
+	if err != nil {
+		return "", "", time.Time{}, fmt.Errorf("invalid IAM role ID %q: %w", roleID, err)
+	}
+
+	cacheKey := fmt.Sprintf("%s/%s/%d", key, id, ttl)
+
+	assumedRolesMu.Lock()
+	defer assumedRolesMu.Unlock()
+
+	if creds, ok := assumedRoles[cacheKey]; ok {
+		expiresAt, err := time.Parse(time.RFC3339, creds.ExpiresAT)
+		if err == nil && time.Now().Before(expiresAt.Add(-assumeRoleRefreshMargin(ttl))) {
+			return creds.Key, creds.Secret, expiresAt, nil
+		}
+	}
+
+	requestedAt := time.Now()
+	creds, err := client.AssumeIAMRole(ctx, id, exov3.AssumeIAMRoleRequest{Ttl: ttl})
+	if err != nil {
+		return "", "", time.Time{}, fmt.Errorf("unable to assume IAM role %q: %w", roleID, err)
+	}
+	assumedRoles[cacheKey] = creds
+
+	expiresAt, err := time.Parse(time.RFC3339, creds.ExpiresAT)
+	if err != nil {
+		expiresAt = requestedAt.Add(time.Duration(ttl) * time.Second)
+	}
+
+	return creds.Key, creds.Secret, expiresAt, nil
+}
+
+// assumeRoleRefreshMargin returns how long before their expiry credentials requested
+// for ttl seconds are renewed: maxAssumeRoleRefreshMargin, or half their lifetime if shorter.
+func assumeRoleRefreshMargin(ttl int64) time.Duration {
+	return min(maxAssumeRoleRefreshMargin, time.Duration(ttl)*time.Second/2)
+}
+
 func GetMultiEnvDefault(ks []string, dv string) string {
 	for _, k := range ks {
 		if v := os.Getenv(k); v != "" {
@@ -10,10 +10,14 @@ import (
 	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 
@@ -23,14 +27,21 @@ import (
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/ai"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/block_storage"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/database"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/iam"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_snapshot"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/kms"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/nlb_service"
 	privatenetwork "github.com/exoscale/terraform-provider-exoscale/pkg/resources/private_network"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/security_group"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/sos_bucket"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/sos_bucket_policy"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/sos_object"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/template"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/vpc"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/zones"
 	"github.com/exoscale/terraform-provider-exoscale/version"
 )
@@ -43,9 +54,11 @@ const (
 	EnvironmentAttrName = "environment"
 	SOSEndpointAttrName = "sos_endpoint"
 	TimeoutAttrName     = "timeout"
+	AssumeRoleAttrName  = "assume_role"
 )
 
 var _ provider.Provider = &ExoscaleProvider{}
+var _ provider.ProviderWithEphemeralResources = &ExoscaleProvider{}
 
 type ExoscaleProvider struct{}
 
@@ -55,6 +68,13 @@ type ExoscaleProviderModel struct {
 	Environment types.String  `tfsdk:"environment"`
 	Timeout     types.Float64 `tfsdk:"timeout"`
 	SOSEndpoint types.String  `tfsdk:"sos_endpoint"`
+
+	AssumeRole []ExoscaleProviderAssumeRoleModel `tfsdk:"assume_role"`
+}
+
+type ExoscaleProviderAssumeRoleModel struct {
+	RoleID types.String `tfsdk:"role_id"`
+	TTL    types.Int64  `tfsdk:"ttl"`
 }
 
 func (p *ExoscaleProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -86,6 +106,31 @@ func (p *ExoscaleProvider) Schema(ctx context.Context, req provider.SchemaReques
 					config.DefaultTimeout.Seconds()),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			AssumeRoleAttrName: schema.ListNestedBlock{
+				MarkdownDescription: "Assume an IAM role: the provider uses the temporary credentials of the role, requested with the configured API key, instead of the key itself",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"role_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "ID of the IAM role to assume",
+						},
+						"ttl": schema.Int64Attribute{
+							Optional: true,
+							MarkdownDescription: fmt.Sprintf(
+								"Lifetime in seconds of the temporary credentials, within the maximum allowed by the role (by default: %d)",
+								providerConfig.DefaultAssumeRoleTTL),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -152,12 +197,43 @@ func (p *ExoscaleProvider) Configure(ctx context.Context, req provider.Configure
 
 	exov2.UserAgent = UserAgent
 
+	opts := []exov3.ClientOpt{}
+	if ep := os.Getenv("EXOSCALE_API_ENDPOINT"); ep != "" {
+		opts = append(opts, exov3.ClientOptWithEndpoint(exov3.Endpoint(ep)), exov3.ClientOptWithUserAgent(UserAgent))
+	}
+
+	var keyExpiresAt time.Time
+	if len(data.AssumeRole) > 0 {
+		assumeRole := data.AssumeRole[0]
+
+		ttl := int64(providerConfig.DefaultAssumeRoleTTL)
+		if !assumeRole.TTL.IsNull() {
+			ttl = assumeRole.TTL.ValueInt64()
+		}
+
+		bootstrapClient, err := exov3.NewClient(credentials.NewStaticCredentials(key, secret), opts...)
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "unable to initialize Exoscale API V3 client")
+
+			return
+		}
+
+		key, secret, keyExpiresAt, err = providerConfig.AssumeRole(ctx, bootstrapClient, key, assumeRole.RoleID.ValueString(), ttl)
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "")
+
+			return
+		}
+	}
+
 	baseConfig := providerConfig.BaseConfig{
 		Key:         key,
 		Secret:      secret,
 		Timeout:     time.Duration(int64(timeout) * int64(time.Second)),
 		Environment: environment,
 		SOSEndpoint: sosEndpoint,
+
+		KeyExpiresAt: keyExpiresAt,
 	}
 
 	clv2, err := exov2.NewClient(
@@ -187,11 +263,6 @@ func (p *ExoscaleProvider) Configure(ctx context.Context, req provider.Configure
 		secret,
 	)
 
-	opts := []exov3.ClientOpt{}
-	if ep := os.Getenv("EXOSCALE_API_ENDPOINT"); ep != "" {
-		opts = append(opts, exov3.ClientOptWithEndpoint(exov3.Endpoint(ep)), exov3.ClientOptWithUserAgent(UserAgent))
-	}
-
 	clv3, err := exov3.NewClient(creds, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(err.Error(), "unable to initialize Exoscale API V3 client")
@@ -212,6 +283,14 @@ func (p *ExoscaleProvider) Configure(ctx context.Context, req provider.Configure
 		Environment: environment,
 		SOSEndpoint: sosEndpoint,
 	}
+
+	resp.EphemeralResourceData = &providerConfig.ExoscaleProviderConfig{
+		Config:      baseConfig,
+		ClientV2:    clv2,
+		ClientV3:    clv3,
+		Environment: environment,
+		SOSEndpoint: sosEndpoint,
+	}
 }
 
 func (p *ExoscaleProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -223,14 +302,24 @@ func (p *ExoscaleProvider) DataSources(ctx context.Context) []func() datasource.
 		iam.NewDataSourceOrgPolicy,
 		iam.NewDataSourceRole,
 		iam.NewDataSourceAPIKey,
+		iam.NewDataSourceUsers,
 		block_storage.NewDataSourceVolume,
 		block_storage.NewDataSourceSnapshot,
+		instance_snapshot.NewDataSourceSnapshot,
 		func() datasource.DataSource {
 			return &nlb_service.NLBServiceListDataSource{}
 		},
+		sos_bucket.NewDataSourceSOSBucket,
 		sos_bucket_policy.NewDataSourceSOSBucketPolicy,
+		sos_object.NewDataSourceSOSObject,
 		security_group.NewDataSource,
 		privatenetwork.NewDataSource,
+		vpc.NewDataSourceVPC,
+		vpc.NewDataSourceSubnet,
+		vpc.NewDataSourceRoute,
+		ai.NewDataSourceInstanceTypes,
+		kms.NewDataSourceKMSKey,
+		kms.NewDataSourceKMSKeyList,
 	}
 }
 
@@ -243,6 +332,10 @@ func (p *ExoscaleProvider) Resources(ctx context.Context) []func() resource.Reso
 		database.NewOpensearchUserResource,
 		database.NewPGUserResource,
 		database.NewValkeyUserResource,
+		database.NewClickhouseUserResource,
+		database.NewKafkaTopicACLResource,
+		database.NewKafkaSchemaRegistryACLResource,
+		database.NewOpensearchACLConfigResource,
 		database.NewPGDatabaseResource,
 		database.NewPGConnectionPoolResource,
 		database.NewMysqlDatabaseResource,
@@ -252,16 +345,42 @@ func (p *ExoscaleProvider) Resources(ctx context.Context) []func() resource.Reso
 		database.NewExternalEndpointElasticsearchResource,
 		database.NewExternalEndpointRsyslogResource,
 		database.NewExternalIntegrationResource,
+		database.NewIntegrationResource,
 		iam.NewResourceOrgPolicy,
 		iam.NewResourceRole,
 		iam.NewResourceAPIKey,
+		iam.NewResourceUser,
 		block_storage.NewResourceVolume,
 		block_storage.NewResourceSnapshot,
+		instance_snapshot.NewResourceSnapshot,
+		instance_snapshot.NewResourceSnapshotExport,
+		template.NewResourceTemplate,
+		sos_bucket.NewResourceSOSBucket,
+		sos_bucket.NewResourceSOSBucketCORSConfiguration,
+		sos_bucket.NewResourceSOSBucketLifecycleConfiguration,
+		sos_bucket.NewResourceSOSBucketVersioning,
 		sos_bucket_policy.NewResourceSOSBucketPolicy,
+		sos_object.NewResourceSOSObject,
 		security_group.NewResource,
 		security_group.NewResourceRule,
 		privatenetwork.NewResource,
+		privatenetwork.NewResourceAttachment,
 		kms.NewResourceKMSKey,
+		vpc.NewResourceVPC,
+		vpc.NewResourceSubnet,
+		vpc.NewResourceRoute,
+		vpc.NewResourceSubnetAttachment,
+		ai.NewResourceModel,
+		ai.NewResourceDeployment,
+		ai.NewResourceAPIKey,
+	}
+}
+
+func (p *ExoscaleProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		iam.NewEphemeralAssumedRoleCredentials,
+		instance.NewEphemeralPassword,
+		sos_object.NewEphemeralPresignedURL,
 	}
 }
 
@@ -0,0 +1,88 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+)
+
+// pollInterval is the delay between two state checks while waiting for a model
+// or a deployment to become ready: model downloads and GPU scheduling are slow.
+const pollInterval = 10 * time.Second
+
+// parseImportID splits an import identifier of the form <ID>@<zone>.
+func parseImportID(importID string) (exoscale.UUID, string, error) {
+	idParts := strings.Split(importID, "@")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected import identifier with format: id@zone. Got: %q", importID)
+	}
+
+	id, err := exoscale.ParseUUID(idParts[0])
+	if err != nil {
+		return "", "", fmt.Errorf("unable to parse ID: %w", err)
+	}
+
+	if !slices.Contains(config.Zones, idParts[1]) {
+		return "", "", fmt.Errorf("zone must be a valid exoscale zone, got: %q", idParts[1])
+	}
+
+	return id, idParts[1], nil
+}
+
+// waitForModel polls the AI model until it reaches the ready state or fails.
+func waitForModel(ctx context.Context, client *exoscale.Client, id exoscale.UUID) (*exoscale.GetModelResponse, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		model, err := client.GetModel(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error polling model state: %w", err)
+		}
+
+		switch model.State {
+		case exoscale.GetModelResponseStateReady:
+			return model, nil
+		case exoscale.GetModelResponseStateError:
+			return nil, fmt.Errorf("model reached error state")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("model %s not ready (state %q): %w", id, model.State, ctx.Err())
+		}
+	}
+}
+
+// waitForDeployment polls the AI deployment until it reaches the ready state or fails.
+func waitForDeployment(ctx context.Context, client *exoscale.Client, id exoscale.UUID) (*exoscale.GetDeploymentResponse, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		deployment, err := client.GetDeployment(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error polling deployment state: %w", err)
+		}
+
+		switch deployment.State {
+		case exoscale.GetDeploymentResponseStateReady:
+			return deployment, nil
+		case exoscale.GetDeploymentResponseStateError:
+			return nil, fmt.Errorf("deployment reached error state: %s", deployment.StateDetails)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("deployment %s not ready (state %q): %w", id, deployment.State, ctx.Err())
+		}
+	}
+}
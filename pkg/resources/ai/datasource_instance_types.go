@@ -0,0 +1,151 @@
+package ai
+
+import (
+	"context"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionDataSourceInstanceTypes = `List the GPU instance types available to Exoscale [Dedicated Inference](https://community.exoscale.com/product/ai/dedicated-inference/) deployments.
+
+Corresponding resource: [exoscale_ai_deployment](../resources/ai_deployment.md).`
+
+var _ datasource.DataSourceWithConfigure = (*DataSourceInstanceTypes)(nil)
+
+type DataSourceInstanceTypes struct {
+	client *exoscale.Client
+}
+
+func NewDataSourceInstanceTypes() datasource.DataSource {
+	return &DataSourceInstanceTypes{}
+}
+
+type DataSourceInstanceTypesModel struct {
+	Zone          types.String `tfsdk:"zone"`
+	InstanceTypes types.List   `tfsdk:"instance_types"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// InstanceTypeModel defines the GPU instance type nested data model.
+type InstanceTypeModel struct {
+	Family     types.String `tfsdk:"family"`
+	Authorized types.Bool   `tfsdk:"authorized"`
+}
+
+// Types returns nested data model types to be used for conversion.
+func (m InstanceTypeModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"family":     types.StringType,
+		"authorized": types.BoolType,
+	}
+}
+
+func (d *DataSourceInstanceTypes) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_instance_types"
+}
+
+func (d *DataSourceInstanceTypes) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescriptionDataSourceInstanceTypes,
+
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Description:         "The Exoscale zone name.",
+				MarkdownDescription: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"instance_types": schema.ListNestedAttribute{
+				Description:         "The list of GPU instance types.",
+				MarkdownDescription: "The list of GPU instance types.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"family": schema.StringAttribute{
+							Description:         "The GPU type family, to be used as the deployment gpu_type.",
+							MarkdownDescription: "The GPU type family, to be used as the deployment `gpu_type`.",
+							Computed:            true,
+						},
+						"authorized": schema.BoolAttribute{
+							Description:         "Whether the organization is authorized to use this GPU type.",
+							MarkdownDescription: "Whether the organization is authorized to use this GPU type.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourceInstanceTypes) Configure(ctx context.Context, r datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if r.ProviderData == nil {
+		return
+	}
+
+	d.client = r.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (d *DataSourceInstanceTypes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataSourceInstanceTypesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, d.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	res, err := client.ListAIInstanceTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when listing AI instance types", err.Error())
+		return
+	}
+
+	instanceTypes := make([]InstanceTypeModel, len(res.InstanceTypes))
+	for i, t := range res.InstanceTypes {
+		instanceTypes[i] = InstanceTypeModel{
+			Family:     types.StringValue(t.Family),
+			Authorized: types.BoolValue(utils.DefaultBool(t.Authorized, false)),
+		}
+	}
+
+	state.InstanceTypes, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: InstanceTypeModel{}.Types()}, instanceTypes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
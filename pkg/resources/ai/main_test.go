@@ -0,0 +1,141 @@
+package ai_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+	tftest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func Test_Resource_AI(t *testing.T) {
+	t.Parallel()
+
+	resourceModel := "exoscale_ai_model.test_model"
+	resourceDeployment := "exoscale_ai_deployment.test_deployment"
+	resourceAPIKey := "exoscale_ai_api_key.test_api_key"
+	datasourceInstanceTypes := "data.exoscale_ai_instance_types.test"
+
+	var apiKeyValue string
+
+	testDataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	importStateIDFunc := func(resource string) func(s *terraform.State) (string, error) {
+		return func(s *terraform.State) (string, error) {
+			return fmt.Sprintf("%s@%s", s.RootModule().Resources[resource].Primary.ID, testDataSpec.Zone), nil
+		}
+	}
+
+	tftest.Test(t, tftest.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []tftest.TestStep{
+			// Create model, deployment and datasource
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.ai_create.tf.tmpl", &testDataSpec),
+				Check: tftest.ComposeAggregateTestCheckFunc(
+					tftest.TestCheckResourceAttrSet(datasourceInstanceTypes, "instance_types.0.family"),
+
+					tftest.TestCheckResourceAttr(resourceModel, "state", "ready"),
+					tftest.TestCheckResourceAttrSet(resourceModel, "model_size"),
+
+					tftest.TestCheckResourceAttr(resourceDeployment, "name", testutils.ResourceName(testDataSpec.ID)),
+					tftest.TestCheckResourceAttrPair(resourceDeployment, "model_id", resourceModel, "id"),
+					tftest.TestCheckResourceAttrPair(resourceDeployment, "model_name", resourceModel, "name"),
+					tftest.TestCheckResourceAttr(resourceDeployment, "replicas", "1"),
+					tftest.TestCheckResourceAttr(resourceDeployment, "state", "ready"),
+					tftest.TestCheckResourceAttrSet(resourceDeployment, "inference_engine_version"),
+					tftest.TestCheckResourceAttrSet(resourceDeployment, "deployment_url"),
+					tftest.TestCheckResourceAttrSet(resourceDeployment, "api_key"),
+
+					tftest.TestCheckResourceAttrPair(resourceAPIKey, "scope", resourceDeployment, "id"),
+					tftest.TestCheckResourceAttrWith(resourceAPIKey, "value", func(value string) error {
+						apiKeyValue = value
+						return nil
+					}),
+				),
+			},
+			// Update name, inference parameters, scale down and rotate the API key
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.ai_update.tf.tmpl", &testDataSpec),
+				ConfigPlanChecks: tftest.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceAPIKey, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: tftest.ComposeAggregateTestCheckFunc(
+					tftest.TestCheckResourceAttr(resourceDeployment, "name", testutils.ResourceName(testDataSpec.ID)+"-updated"),
+					tftest.TestCheckResourceAttr(resourceDeployment, "replicas", "0"),
+					tftest.TestCheckResourceAttr(resourceDeployment, "inference_engine_parameters.#", "1"),
+					tftest.TestCheckResourceAttr(resourceDeployment, "inference_engine_parameters.0", "--max-model-len=4096"),
+
+					tftest.TestCheckResourceAttr(resourceAPIKey, "name", testutils.ResourceName(testDataSpec.ID)+"-updated"),
+					tftest.TestCheckResourceAttr(resourceAPIKey, "scope", "public"),
+					tftest.TestCheckResourceAttrWith(resourceAPIKey, "value", func(value string) error {
+						if value == apiKeyValue {
+							return fmt.Errorf("expected API key value to be rotated")
+						}
+						return nil
+					}),
+				),
+			},
+			// Import
+			{
+				ResourceName:            resourceModel,
+				ImportStateIdFunc:       importStateIDFunc(resourceModel),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"huggingface_token"},
+			},
+			{
+				ResourceName:      resourceDeployment,
+				ImportStateIdFunc: importStateIDFunc(resourceDeployment),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            resourceAPIKey,
+				ImportStateIdFunc:       importStateIDFunc(resourceAPIKey),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_trigger"},
+			},
+			// Clear the inference parameters
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/003.ai_clear_parameters.tf.tmpl", &testDataSpec),
+				Check: tftest.ComposeAggregateTestCheckFunc(
+					tftest.TestCheckResourceAttr(resourceDeployment, "replicas", "0"),
+					tftest.TestCheckResourceAttr(resourceDeployment, "inference_engine_parameters.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func Test_Resource_AI_DeploymentNoReplicas(t *testing.T) {
+	t.Parallel()
+
+	testDataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	tftest.Test(t, tftest.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []tftest.TestStep{
+			{
+				Config:      testutils.ParseTestdataConfig("./testdata/004.ai_deployment_no_replicas.tf.tmpl", &testDataSpec),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("a deployment must be created with at least 1 replica"),
+			},
+		},
+	})
+}
@@ -0,0 +1,367 @@
+package ai
+
+import (
+	"context"
+	"errors"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionResourceAPIKey = `Manage Exoscale [Dedicated Inference](https://community.exoscale.com/product/ai/dedicated-inference/) API keys.
+
+An AI API key authenticates requests against the inference endpoint of every [exoscale_ai_deployment](./ai_deployment.md) (` + "`public`" + ` scope) or of a single one.
+
+The key secret can be rotated in place by changing the ` + "`rotation_trigger`" + ` attribute.
+`
+
+// apiKeyScopePublic is the scope granting access to all deployments.
+const apiKeyScopePublic = "public"
+
+var _ resource.Resource = &ResourceAPIKey{}
+var _ resource.ResourceWithImportState = &ResourceAPIKey{}
+var _ resource.ResourceWithModifyPlan = &ResourceAPIKey{}
+
+// ResourceAPIKeyModel holds the Terraform state for an AI API key.
+type ResourceAPIKeyModel struct {
+	ID              types.String `tfsdk:"id"`
+	Zone            types.String `tfsdk:"zone"`
+	Name            types.String `tfsdk:"name"`
+	Scope           types.String `tfsdk:"scope"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	Value           types.String `tfsdk:"value"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ResourceAPIKey struct {
+	client *exoscale.Client
+}
+
+func NewResourceAPIKey() resource.Resource {
+	return &ResourceAPIKey{}
+}
+
+func (r *ResourceAPIKey) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_api_key"
+}
+
+func (r *ResourceAPIKey) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescriptionResourceAPIKey,
+		Description:         "Manage Exoscale Dedicated Inference API keys.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Description:         "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Description:         "The Exoscale Zone name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The AI API key name.",
+				Description:         "The AI API key name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "The AI API key scope: `public` (default) for all deployments, or an [exoscale_ai_deployment](./ai_deployment.md) ID.",
+				Description:         "The AI API key scope: public (default) for all deployments, or an AI deployment ID.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(apiKeyScopePublic),
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value, changing it rotates the key secret `value` in place (e.g. a date or a [time_rotating](https://registry.terraform.io/providers/hashicorp/time/latest/docs/resources/rotating) ID).",
+				Description:         "An arbitrary value, changing it rotates the key secret value in place.",
+				Optional:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The AI API key secret value.",
+				Description:         "The AI API key secret value.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The AI API key creation date.",
+				Description:         "The AI API key creation date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The AI API key last update date.",
+				Description:         "The AI API key last update date.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceAPIKey) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// ModifyPlan marks the key value as unknown when a rotation is requested,
+// since UseStateForUnknown would otherwise plan the previous value.
+func (r *ResourceAPIKey) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		// Create or destroy: nothing to rotate.
+		return
+	}
+
+	var plan, state ResourceAPIKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RotationTrigger.Equal(state.RotationTrigger) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), types.StringUnknown())...)
+	}
+}
+
+func (r *ResourceAPIKey) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceAPIKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	key, err := client.CreateAIAPIKey(ctx, exoscale.CreateAIAPIKeyRequest{
+		Name:  plan.Name.ValueString(),
+		Scope: plan.Scope.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when creating AI API key", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(key.ID.String())
+	plan.Name = types.StringValue(key.Name)
+	plan.Scope = types.StringValue(key.Scope)
+	plan.Value = types.StringValue(key.Value)
+	plan.CreatedAt = types.StringValue(key.CreatedAT.String())
+	plan.UpdatedAt = types.StringValue(key.UpdatedAT.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceAPIKey) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceAPIKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	id := exoscale.UUID(state.ID.ValueString())
+
+	key, err := client.GetAIAPIKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			tflog.Info(ctx, "AI API key not found, removing from state", map[string]any{})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error while reading AI API key", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(key.Name)
+	state.Scope = types.StringValue(key.Scope)
+	state.CreatedAt = types.StringValue(key.CreatedAT.String())
+	state.UpdatedAt = types.StringValue(key.UpdatedAT.String())
+
+	// The secret value is only fetched when unknown to the state (e.g. after an import).
+	if state.Value.IsNull() {
+		value, err := client.RevealAIAPIKey(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("API returned an error when revealing AI API key", err.Error())
+			return
+		}
+		state.Value = types.StringValue(value.Value)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceAPIKey) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ResourceAPIKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	id := exoscale.UUID(state.ID.ValueString())
+
+	if !plan.Name.Equal(state.Name) || !plan.Scope.Equal(state.Scope) {
+		if _, err := client.UpdateAIAPIKey(ctx, id, exoscale.UpdateAIAPIKeyRequest{
+			Name:  plan.Name.ValueString(),
+			Scope: plan.Scope.ValueString(),
+		}); err != nil {
+			resp.Diagnostics.AddError("API returned an error when updating AI API key", err.Error())
+			return
+		}
+	}
+
+	if !plan.RotationTrigger.Equal(state.RotationTrigger) {
+		value, err := client.RotateAIAPIKey(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("API returned an error when rotating AI API key", err.Error())
+			return
+		}
+		plan.Value = types.StringValue(value.Value)
+	}
+
+	key, err := client.GetAIAPIKey(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error while reading AI API key", err.Error())
+		return
+	}
+	plan.UpdatedAt = types.StringValue(key.UpdatedAT.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceAPIKey) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceAPIKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	op, err := client.DeleteAIAPIKey(ctx, exoscale.UUID(state.ID.ValueString()))
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error when deleting AI API key", err.Error())
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+		resp.Diagnostics.AddError("delete AI API key operation failed", err.Error())
+		return
+	}
+}
+
+func (r *ResourceAPIKey) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, zone, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var t timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceAPIKeyModel{
+		ID:              types.StringValue(id.String()),
+		Zone:            types.StringValue(zone),
+		RotationTrigger: types.StringNull(),
+		Value:           types.StringNull(),
+		Timeouts:        t,
+	})...)
+}
@@ -0,0 +1,604 @@
+package ai
+
+import (
+	"context"
+	"errors"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionResourceDeployment = `Manage Exoscale [Dedicated Inference](https://community.exoscale.com/product/ai/dedicated-inference/) Deployments.
+
+A deployment serves an [exoscale_ai_model](./ai_model.md) on dedicated GPUs, behind an OpenAI-compatible endpoint.
+Resource creation and updates wait for the deployment to be ready.
+
+Available GPU types can be listed with the [exoscale_ai_instance_types](../data-sources/ai_instance_types.md) data source.
+`
+
+var _ resource.Resource = &ResourceDeployment{}
+var _ resource.ResourceWithImportState = &ResourceDeployment{}
+var _ resource.ResourceWithModifyPlan = &ResourceDeployment{}
+
+// ResourceDeploymentModel holds the Terraform state for an AI deployment.
+type ResourceDeploymentModel struct {
+	ID                        types.String `tfsdk:"id"`
+	Zone                      types.String `tfsdk:"zone"`
+	Name                      types.String `tfsdk:"name"`
+	ModelID                   types.String `tfsdk:"model_id"`
+	ModelName                 types.String `tfsdk:"model_name"`
+	GPUType                   types.String `tfsdk:"gpu_type"`
+	GPUCount                  types.Int64  `tfsdk:"gpu_count"`
+	Replicas                  types.Int64  `tfsdk:"replicas"`
+	InferenceEngineVersion    types.String `tfsdk:"inference_engine_version"`
+	InferenceEngineParameters types.List   `tfsdk:"inference_engine_parameters"`
+	DeploymentURL             types.String `tfsdk:"deployment_url"`
+	APIKey                    types.String `tfsdk:"api_key"`
+	ServiceLevel              types.String `tfsdk:"service_level"`
+	State                     types.String `tfsdk:"state"`
+	CreatedAt                 types.String `tfsdk:"created_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *ResourceDeploymentModel) apply(ctx context.Context, deployment *exoscale.GetDeploymentResponse) diag.Diagnostics {
+	m.ID = types.StringValue(deployment.ID.String())
+	m.Name = types.StringValue(deployment.Name)
+	m.GPUType = types.StringValue(deployment.GpuType)
+	m.GPUCount = types.Int64Value(deployment.GpuCount)
+	m.Replicas = types.Int64Value(deployment.Replicas)
+	m.InferenceEngineVersion = types.StringValue(string(deployment.InferenceEngineVersion))
+	m.DeploymentURL = types.StringValue(deployment.DeploymentURL)
+	m.ServiceLevel = types.StringValue(deployment.ServiceLevel)
+	m.State = types.StringValue(string(deployment.State))
+	m.CreatedAt = types.StringValue(deployment.CreatedAT.String())
+
+	if deployment.Model != nil {
+		m.ModelID = types.StringValue(deployment.Model.ID.String())
+		m.ModelName = types.StringValue(deployment.Model.Name)
+	}
+
+	// An explicitly empty list is kept as configured, the API reporting no parameters.
+	emptyParams := !m.InferenceEngineParameters.IsNull() && !m.InferenceEngineParameters.IsUnknown() &&
+		len(m.InferenceEngineParameters.Elements()) == 0
+
+	m.InferenceEngineParameters = types.ListNull(types.StringType)
+	if emptyParams && len(deployment.InferenceEngineParameters) == 0 {
+		m.InferenceEngineParameters = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+	if len(deployment.InferenceEngineParameters) > 0 {
+		params, diags := types.ListValueFrom(ctx, types.StringType, deployment.InferenceEngineParameters)
+		if diags.HasError() {
+			return diags
+		}
+		m.InferenceEngineParameters = params
+	}
+
+	return nil
+}
+
+func (m *ResourceDeploymentModel) inferenceEngineParameters(ctx context.Context) ([]string, diag.Diagnostics) {
+	if m.InferenceEngineParameters.IsNull() || m.InferenceEngineParameters.IsUnknown() {
+		return nil, nil
+	}
+
+	var params []string
+	diags := m.InferenceEngineParameters.ElementsAs(ctx, &params, false)
+	return params, diags
+}
+
+type ResourceDeployment struct {
+	client *exoscale.Client
+}
+
+func NewResourceDeployment() resource.Resource {
+	return &ResourceDeployment{}
+}
+
+func (r *ResourceDeployment) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_deployment"
+}
+
+func (r *ResourceDeployment) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescriptionResourceDeployment,
+		Description:         "Manage Exoscale Dedicated Inference Deployments.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Description:         "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Description:         "The Exoscale Zone name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The deployment name.",
+				Description:         "The deployment name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"model_id": schema.StringAttribute{
+				MarkdownDescription: "❗ The [exoscale_ai_model](./ai_model.md) (ID) to deploy (conflicts with `model_name`).",
+				Description:         "The AI model (ID) to deploy (conflicts with model_name).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("model_name")),
+				},
+			},
+			"model_name": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the AI model to deploy (conflicts with `model_id`).",
+				Description:         "The name of the AI model to deploy (conflicts with model_id).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("model_id")),
+				},
+			},
+			"gpu_type": schema.StringAttribute{
+				MarkdownDescription: "❗ The GPU type family (e.g. `gpua5000`), see the [exoscale_ai_instance_types](../data-sources/ai_instance_types.md) data source.",
+				Description:         "The GPU type family (e.g. gpua5000).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gpu_count": schema.Int64Attribute{
+				MarkdownDescription: "❗ The number of GPUs per replica (1-8).",
+				Description:         "The number of GPUs per replica (1-8).",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 8),
+				},
+			},
+			"replicas": schema.Int64Attribute{
+				MarkdownDescription: "The number of replicas. It must be at least 1 at creation, and can be scaled down to 0 afterwards.",
+				Description:         "The number of replicas. It must be at least 1 at creation, and can be scaled down to 0 afterwards.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"inference_engine_version": schema.StringAttribute{
+				MarkdownDescription: "The inference engine version (defaults to the latest version).",
+				Description:         "The inference engine version (defaults to the latest version).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"inference_engine_parameters": schema.ListAttribute{
+				MarkdownDescription: "Extra inference engine server CLI arguments (e.g. `[\"--max-model-len=8192\"]`).",
+				Description:         "Extra inference engine server CLI arguments.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"deployment_url": schema.StringAttribute{
+				MarkdownDescription: "The deployment inference endpoint URL.",
+				Description:         "The deployment inference endpoint URL.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "The deployment inference endpoint authentication key.",
+				Description:         "The deployment inference endpoint authentication key.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_level": schema.StringAttribute{
+				MarkdownDescription: "The deployment service level.",
+				Description:         "The deployment service level.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The deployment state.",
+				Description:         "The deployment state.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The deployment creation date.",
+				Description:         "The deployment creation date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceDeployment) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// ModifyPlan rejects the creation of a deployment without replicas,
+// a deployment can only be scaled down to 0 afterwards.
+func (r *ResourceDeployment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		// Update or destroy: nothing to check.
+		return
+	}
+
+	var replicas types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replicas"), &replicas)...)
+	if resp.Diagnostics.HasError() || replicas.IsUnknown() {
+		return
+	}
+
+	if replicas.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("replicas"),
+			"invalid replicas count",
+			"a deployment must be created with at least 1 replica",
+		)
+	}
+}
+
+func (r *ResourceDeployment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceDeploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	params, diags := plan.inferenceEngineParameters(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := exoscale.CreateDeploymentRequest{
+		Name:                      plan.Name.ValueString(),
+		GpuType:                   plan.GPUType.ValueString(),
+		GpuCount:                  plan.GPUCount.ValueInt64(),
+		Replicas:                  plan.Replicas.ValueInt64(),
+		InferenceEngineParameters: params,
+		Model:                     &exoscale.ModelRef{},
+	}
+	if !plan.InferenceEngineVersion.IsUnknown() {
+		request.InferenceEngineVersion = exoscale.InferenceEngineVersion(plan.InferenceEngineVersion.ValueString())
+	}
+	if !plan.ModelID.IsUnknown() && !plan.ModelID.IsNull() {
+		request.Model.ID = exoscale.UUID(plan.ModelID.ValueString())
+	} else {
+		request.Model.Name = plan.ModelName.ValueString()
+	}
+
+	op, err := client.CreateDeployment(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when creating AI deployment", err.Error())
+		return
+	}
+
+	op, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError("create AI deployment operation failed", err.Error())
+		return
+	}
+
+	if op.Reference == nil {
+		resp.Diagnostics.AddError("create AI deployment operation failed", "operation has no reference")
+		return
+	}
+	id := op.Reference.ID
+
+	// Save the deployment right away, so that a deployment failing to become ready is still tracked.
+	plan.ID = types.StringValue(id.String())
+	tracked := plan
+	if tracked.ModelID.IsUnknown() {
+		tracked.ModelID = types.StringNull()
+	}
+	if tracked.ModelName.IsUnknown() {
+		tracked.ModelName = types.StringNull()
+	}
+	if tracked.InferenceEngineVersion.IsUnknown() {
+		tracked.InferenceEngineVersion = types.StringNull()
+	}
+	tracked.DeploymentURL = types.StringNull()
+	tracked.APIKey = types.StringNull()
+	tracked.ServiceLevel = types.StringNull()
+	tracked.State = types.StringNull()
+	tracked.CreatedAt = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tracked)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := waitForDeployment(ctx, client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("AI deployment did not become ready", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.apply(ctx, deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := client.RevealDeploymentAPIKey(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when revealing AI deployment API key", err.Error())
+		return
+	}
+	plan.APIKey = types.StringValue(apiKey.APIKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceDeployment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceDeploymentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	id := exoscale.UUID(state.ID.ValueString())
+
+	deployment, err := client.GetDeployment(ctx, id)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			tflog.Info(ctx, "AI deployment not found, removing from state", map[string]any{})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error while reading AI deployment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.apply(ctx, deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API key is only revealed once (e.g. after an import).
+	if state.APIKey.IsNull() {
+		apiKey, err := client.RevealDeploymentAPIKey(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("API returned an error when revealing AI deployment API key", err.Error())
+			return
+		}
+		state.APIKey = types.StringValue(apiKey.APIKey)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceDeployment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ResourceDeploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	id := exoscale.UUID(state.ID.ValueString())
+
+	if !plan.Name.Equal(state.Name) ||
+		!plan.InferenceEngineParameters.Equal(state.InferenceEngineParameters) ||
+		(!plan.InferenceEngineVersion.IsUnknown() && !plan.InferenceEngineVersion.Equal(state.InferenceEngineVersion)) {
+		params, diags := plan.inferenceEngineParameters(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updateClient := client
+		if len(params) == 0 && !plan.InferenceEngineParameters.Equal(state.InferenceEngineParameters) {
+			// The request omits empty parameters: send them explicitly to clear the removed ones.
+			updateClient = utils.WithJSONBodyFields(client, map[string]any{
+				"inference-engine-parameters": []string{},
+			})
+		}
+
+		op, err := updateClient.UpdateDeployment(ctx, id, exoscale.UpdateDeploymentRequest{
+			Name:                      plan.Name.ValueString(),
+			InferenceEngineVersion:    exoscale.InferenceEngineVersion(plan.InferenceEngineVersion.ValueString()),
+			InferenceEngineParameters: params,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API returned an error when updating AI deployment", err.Error())
+			return
+		}
+
+		if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+			resp.Diagnostics.AddError("update AI deployment operation failed", err.Error())
+			return
+		}
+	}
+
+	if !plan.Replicas.Equal(state.Replicas) {
+		op, err := client.ScaleDeployment(ctx, id, exoscale.ScaleDeploymentRequest{
+			Replicas: plan.Replicas.ValueInt64(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API returned an error when scaling AI deployment", err.Error())
+			return
+		}
+
+		if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+			resp.Diagnostics.AddError("scale AI deployment operation failed", err.Error())
+			return
+		}
+	}
+
+	var deployment *exoscale.GetDeploymentResponse
+	if plan.Replicas.ValueInt64() == 0 {
+		// A deployment scaled down to zero replicas never becomes ready.
+		deployment, err = client.GetDeployment(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("API returned an error while fetching AI deployment", err.Error())
+			return
+		}
+	} else {
+		deployment, err = waitForDeployment(ctx, client, id)
+		if err != nil {
+			resp.Diagnostics.AddError("AI deployment did not become ready", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(plan.apply(ctx, deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceDeployment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceDeploymentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	op, err := client.DeleteDeployment(ctx, exoscale.UUID(state.ID.ValueString()))
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error when deleting AI deployment", err.Error())
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+		resp.Diagnostics.AddError("delete AI deployment operation failed", err.Error())
+		return
+	}
+}
+
+func (r *ResourceDeployment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, zone, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var t timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceDeploymentModel{
+		ID:                        types.StringValue(id.String()),
+		Zone:                      types.StringValue(zone),
+		InferenceEngineParameters: types.ListNull(types.StringType),
+		APIKey:                    types.StringNull(),
+		Timeouts:                  t,
+	})...)
+}
@@ -0,0 +1,310 @@
+package ai
+
+import (
+	"context"
+	"errors"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionResourceModel = `Manage Exoscale [Dedicated Inference](https://community.exoscale.com/product/ai/dedicated-inference/) Models.
+
+A model is downloaded from [Hugging Face](https://huggingface.co/) and can then be served by one or more [exoscale_ai_deployment](./ai_deployment.md).
+Resource creation waits for the model download to complete.
+`
+
+var _ resource.Resource = &ResourceModel{}
+var _ resource.ResourceWithImportState = &ResourceModel{}
+
+// ResourceModelModel holds the Terraform state for an AI model.
+type ResourceModelModel struct {
+	ID               types.String `tfsdk:"id"`
+	Zone             types.String `tfsdk:"zone"`
+	Name             types.String `tfsdk:"name"`
+	HuggingfaceToken types.String `tfsdk:"huggingface_token"`
+	State            types.String `tfsdk:"state"`
+	ModelSize        types.Int64  `tfsdk:"model_size"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *ResourceModelModel) apply(model *exoscale.GetModelResponse) {
+	m.ID = types.StringValue(model.ID.String())
+	m.Name = types.StringValue(model.Name)
+	m.State = types.StringValue(string(model.State))
+	m.ModelSize = types.Int64Value(model.ModelSize)
+	m.CreatedAt = types.StringValue(model.CreatedAT.String())
+}
+
+type ResourceModel struct {
+	client *exoscale.Client
+}
+
+func NewResourceModel() resource.Resource {
+	return &ResourceModel{}
+}
+
+func (r *ResourceModel) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_model"
+}
+
+func (r *ResourceModel) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescriptionResourceModel,
+		Description:         "Manage Exoscale Dedicated Inference Models.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Description:         "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Description:         "The Exoscale Zone name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "❗ The Hugging Face model name (e.g. `Qwen/Qwen2.5-0.5B-Instruct`).",
+				Description:         "The Hugging Face model name (e.g. Qwen/Qwen2.5-0.5B-Instruct).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"huggingface_token": schema.StringAttribute{
+				MarkdownDescription: "❗ A Hugging Face access token, required to download gated models. It is only used at creation and not read back.",
+				Description:         "A Hugging Face access token, required to download gated models. It is only used at creation and not read back.",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The model state.",
+				Description:         "The model state.",
+				Computed:            true,
+			},
+			"model_size": schema.Int64Attribute{
+				MarkdownDescription: "The model size (in bytes).",
+				Description:         "The model size (in bytes).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The model creation date.",
+				Description:         "The model creation date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ResourceModel) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *ResourceModel) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceModelModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	op, err := client.CreateModel(ctx, exoscale.CreateModelRequest{
+		Name:             plan.Name.ValueString(),
+		HuggingfaceToken: plan.HuggingfaceToken.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when creating AI model", err.Error())
+		return
+	}
+
+	op, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError("create AI model operation failed", err.Error())
+		return
+	}
+
+	if op.Reference == nil {
+		resp.Diagnostics.AddError("create AI model operation failed", "operation has no reference")
+		return
+	}
+
+	// Save the model right away, so that a model failing to download is still tracked.
+	plan.ID = types.StringValue(op.Reference.ID.String())
+	tracked := plan
+	tracked.State = types.StringNull()
+	tracked.ModelSize = types.Int64Null()
+	tracked.CreatedAt = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tracked)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := waitForModel(ctx, client, op.Reference.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("AI model did not become ready", err.Error())
+		return
+	}
+
+	plan.apply(model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceModel) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceModelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	model, err := client.GetModel(ctx, exoscale.UUID(state.ID.ValueString()))
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			tflog.Info(ctx, "AI model not found, removing from state", map[string]any{})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error while reading AI model", err.Error())
+		return
+	}
+
+	state.apply(model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is a no-op: all mutable attributes use RequiresReplace.
+func (r *ResourceModel) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *ResourceModel) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceModelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	op, err := client.DeleteModel(ctx, exoscale.UUID(state.ID.ValueString()))
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error when deleting AI model", err.Error())
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+		resp.Diagnostics.AddError("delete AI model operation failed", err.Error())
+		return
+	}
+}
+
+func (r *ResourceModel) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, zone, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var t timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceModelModel{
+		ID:               types.StringValue(id.String()),
+		Zone:             types.StringValue(zone),
+		HuggingfaceToken: types.StringNull(),
+		Timeouts:         t,
+	})...)
+}
@@ -8,5 +8,7 @@ var (
 		"valkey",
 		"opensearch",
 		"grafana",
+		"clickhouse",
+		"thanos",
 	}
 )
@@ -110,7 +110,7 @@ func (d *DataSourceURI) Schema(
 				Required:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of the database service (`kafka`, `mysql`, `opensearch`, `pg`, `valkey`, `grafana`).",
+				MarkdownDescription: "The type of the database service (`kafka`, `mysql`, `opensearch`, `pg`, `valkey`, `grafana`, `clickhouse`, `thanos`).",
 				Required:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf(ServicesList...),
@@ -557,6 +557,102 @@ func (d *DataSourceURI) Read(ctx context.Context, req datasource.ReadRequest, re
 			return
 		}
 
+		params["password"] = creds.Password
+	case "clickhouse":
+		res, err := waitForDBAASService(
+			ctx,
+			client.GetDBAASServiceClickhouse,
+			data.Name.ValueString(),
+			func(s *exoscale.DBAASServiceClickhouse) string { return string(s.State) },
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read Database Service ClickHouse: %s", err),
+			)
+			return
+		}
+
+		params = res.URIParams
+		if i, ok := params["user"]; ok {
+			if s, ok := i.(string); ok {
+				user = s
+			}
+		}
+		if user == "" {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				"Database Service ClickHouse user is empty",
+			)
+			return
+		}
+		data.Schema = types.StringValue("https")
+
+		creds, err := client.RevealDBAASClickhouseUserPassword(ctx, data.Name.ValueString(), user)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to reveal Database Service ClickHouse secret: %s", err),
+			)
+			return
+		}
+
+		uri, err = uriWithPassword(res.URI, creds.Username, creds.Password)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to parse Database Service ClickHouse secret: %s", err),
+			)
+			return
+		}
+
+		params["password"] = creds.Password
+	case "thanos":
+		res, err := waitForDBAASService(
+			ctx,
+			client.GetDBAASServiceThanos,
+			data.Name.ValueString(),
+			func(s *exoscale.DBAASServiceThanos) string { return string(s.State) },
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read Database Service Thanos: %s", err),
+			)
+			return
+		}
+
+		params = res.URIParams
+		if i, ok := params["user"]; ok {
+			if s, ok := i.(string); ok {
+				user = s
+			}
+		}
+		if user == "" {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				"Database Service Thanos user is empty",
+			)
+			return
+		}
+		data.Schema = types.StringValue("https")
+
+		creds, err := client.RevealDBAASThanosUserPassword(ctx, data.Name.ValueString(), user)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to reveal Database Service Thanos secret: %s", err),
+			)
+			return
+		}
+
+		uri, err = uriWithPassword(res.URI, creds.Username, creds.Password)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to parse Database Service Thanos secret: %s", err),
+			)
+			return
+		}
+
 		params["password"] = creds.Password
 	}
 
@@ -25,7 +25,11 @@ func TestDatabase(t *testing.T) {
 	t.Run("ResourceMysql", testResourceMysql)
 	t.Run("ResourceValkey", testResourceValkey)
 	t.Run("ResourceValkeyUser", testResourceValkeyUser)
+	t.Run("ResourceClickhouse", testResourceClickhouse)
+	t.Run("ResourceClickhouseUser", testResourceClickhouseUser)
+	t.Run("ResourceThanos", testResourceThanos)
 	t.Run("ResourceKafka", testResourceKafka)
+	t.Run("ResourceKafkaACL", testResourceKafkaACL)
 	t.Run("ResourceOpensearch", testResourceOpensearch)
 	t.Run("ResourceGrafana", testResourceGrafana)
 	t.Run("DataSourceURI", testDataSourceURI)
@@ -35,6 +39,7 @@ func TestDatabase(t *testing.T) {
 	t.Run("ResourceDBAASExternalEndpointElasticsearch", testResourceExternalEndpointElasticsearch)
 	t.Run("ResourceDBAASExternalEndpointRsyslog", testResourceExternalEndpointRsyslog)
 	t.Run("ResourceDBAASExternalIntegration", testResourceExternalIntegration)
+	t.Run("ResourceDBAASIntegration", testResourceIntegration)
 }
 
 func CheckServiceDestroy(dbType, name string) resource.TestCheckFunc {
@@ -77,6 +82,10 @@ func CheckServiceDestroy(dbType, name string) resource.TestCheckFunc {
 			_, serviceErr = client.GetDbaasServicePgWithResponse(ctx, oapi.DbaasServiceName(name))
 		case "valkey":
 			_, serviceErr = clientV3.GetDBAASServiceValkey(ctxV3, name)
+		case "clickhouse":
+			_, serviceErr = clientV3.GetDBAASServiceClickhouse(ctxV3, name)
+		case "thanos":
+			_, serviceErr = clientV3.GetDBAASServiceThanos(ctxV3, name)
 		case "opensearch":
 			_, serviceErr = client.GetDbaasServiceOpensearchWithResponse(ctx, oapi.DbaasServiceName(name))
 		default:
@@ -0,0 +1,463 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &IntegrationResource{}
+var _ resource.ResourceWithImportState = &IntegrationResource{}
+
+func NewIntegrationResource() resource.Resource {
+	return &IntegrationResource{}
+}
+
+type IntegrationResource struct {
+	client *v3.Client
+}
+
+type IntegrationResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	SourceServiceName types.String   `tfsdk:"source_service_name"`
+	DestServiceName   types.String   `tfsdk:"dest_service_name"`
+	Type              types.String   `tfsdk:"type"`
+	Settings          types.String   `tfsdk:"settings"`
+	Zone              types.String   `tfsdk:"zone"`
+	Description       types.String   `tfsdk:"description"`
+	Status            types.String   `tfsdk:"status"`
+	IsActive          types.Bool     `tfsdk:"is_active"`
+	IsEnabled         types.Bool     `tfsdk:"is_enabled"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *IntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *IntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_integration"
+}
+
+func (r *IntegrationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage Exoscale DBaaS [Integrations](https://community.exoscale.com/documentation/dbaas/) between two DBaaS services (e.g. Grafana datasources, metrics or logs forwarding).\n\n" +
+			"To link a DBaaS service to an external endpoint, use [exoscale_dbaas_external_integration](./dbaas_external_integration.md) instead.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource (integration UUID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_service_name": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the source DBaaS service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dest_service_name": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the destination DBaaS service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "❗ The integration type (e.g. `datasource`, `logs`, `metrics`). It must support the source and destination service types (`exo dbaas integration types` for reference).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"settings": schema.StringAttribute{
+				MarkdownDescription: "Integration settings in JSON format (`exo dbaas integration settings <TYPE> <SOURCE_TYPE> <DEST_TYPE>` for reference).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Integration description.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Integration status.",
+				Computed:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the integration is active.",
+				Computed:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the integration is enabled.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, diags := data.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, v3.ZoneName(data.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	settings, err := validateIntegration(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation error", err.Error())
+		return
+	}
+
+	op, err := client.CreateDBAASIntegration(ctx, v3.CreateDBAASIntegrationRequest{
+		SourceService:   v3.DBAASServiceName(data.SourceServiceName.ValueString()),
+		DestService:     v3.DBAASServiceName(data.DestServiceName.ValueString()),
+		IntegrationType: v3.EnumIntegrationTypes(data.Type.ValueString()),
+		Settings:        settings,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("create", fmt.Sprintf("error creating dbaas integration: %s", err))
+		return
+	}
+
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError("create", fmt.Sprintf("error creating dbaas integration: %s", err))
+		return
+	}
+
+	integration, err := client.GetDBAASIntegration(ctx, op.Reference.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("read", fmt.Sprintf("error reading dbaas integration: %s", err))
+		return
+	}
+
+	if err := readDBAASIntegrationIntoModel(integration, &data); err != nil {
+		resp.Diagnostics.AddError("read", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "resource created", map[string]any{"id": data.ID.ValueString()})
+}
+
+func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, v3.ZoneName(data.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	integrationID, err := v3.ParseUUID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parse ID", fmt.Sprintf("error parsing integration ID: %s", err))
+		return
+	}
+
+	integration, err := client.GetDBAASIntegration(ctx, integrationID)
+	if errors.Is(err, v3.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("read", fmt.Sprintf("error reading dbaas integration: %s", err))
+		return
+	}
+
+	if err := readDBAASIntegrationIntoModel(integration, &data); err != nil {
+		resp.Diagnostics.AddError("read", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var stateData, planData IntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, diags := stateData.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, v3.ZoneName(planData.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	integrationID, err := v3.ParseUUID(stateData.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parse ID", fmt.Sprintf("error parsing integration ID: %s", err))
+		return
+	}
+
+	if !planData.Settings.Equal(stateData.Settings) {
+		settings, err := validateIntegration(ctx, client, &planData)
+		if err != nil {
+			resp.Diagnostics.AddError("Validation error", err.Error())
+			return
+		}
+		if settings == nil {
+			settings = map[string]any{}
+		}
+
+		op, err := client.UpdateDBAASIntegration(ctx, integrationID, v3.UpdateDBAASIntegrationRequest{
+			Settings: settings,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("update", fmt.Sprintf("error updating dbaas integration: %s", err))
+			return
+		}
+
+		if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+			resp.Diagnostics.AddError("update", fmt.Sprintf("error updating dbaas integration: %s", err))
+			return
+		}
+	}
+
+	integration, err := client.GetDBAASIntegration(ctx, integrationID)
+	if err != nil {
+		resp.Diagnostics.AddError("read", fmt.Sprintf("error reading dbaas integration: %s", err))
+		return
+	}
+
+	if err := readDBAASIntegrationIntoModel(integration, &planData); err != nil {
+		resp.Diagnostics.AddError("read", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Trace(ctx, "resource updated", map[string]any{"id": planData.ID.ValueString()})
+}
+
+func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, diags := data.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, v3.ZoneName(data.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	integrationID, err := v3.ParseUUID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parse ID", fmt.Sprintf("error parsing integration ID: %s", err))
+		return
+	}
+
+	op, err := client.DeleteDBAASIntegration(ctx, integrationID)
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("delete", fmt.Sprintf("error deleting dbaas integration: %s", err))
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+		resp.Diagnostics.AddError("delete", fmt.Sprintf("error deleting dbaas integration: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]any{"id": data.ID.ValueString()})
+}
+
+func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	integrationID, zone, err := parseZonedImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("import ID", fmt.Sprintf("error parsing import ID: %s", err))
+		return
+	}
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var resourceTimeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &resourceTimeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), integrationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), zone)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), resourceTimeouts)...)
+}
+
+// validateIntegration checks the integration type supports the source and destination
+// service types, and validates the user settings against the API JSON schema.
+func validateIntegration(ctx context.Context, client *v3.Client, data *IntegrationResourceModel) (map[string]any, error) {
+	services, err := client.ListDBAASServices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list database services: %w", err)
+	}
+
+	source, err := services.FindDBAASServiceCommon(data.SourceServiceName.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("source service %q: %w", data.SourceServiceName.ValueString(), err)
+	}
+	dest, err := services.FindDBAASServiceCommon(data.DestServiceName.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("destination service %q: %w", data.DestServiceName.ValueString(), err)
+	}
+	sourceType, destType := string(source.Type), string(dest.Type)
+
+	integrationTypes, err := client.ListDBAASIntegrationTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list integration types: %w", err)
+	}
+
+	supported := []string{}
+	found := false
+	for _, it := range integrationTypes.DBAASIntegrationTypes {
+		if slices.Contains(it.SourceServiceTypes, sourceType) && slices.Contains(it.DestServiceTypes, destType) {
+			supported = append(supported, it.Type)
+			if it.Type == data.Type.ValueString() {
+				found = true
+			}
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf(
+			"integration type %q is not supported from %s to %s service (supported types: %s)",
+			data.Type.ValueString(),
+			sourceType,
+			destType,
+			strings.Join(supported, ", "),
+		)
+	}
+
+	if data.Settings.IsUnknown() || data.Settings.ValueString() == "" {
+		return nil, nil
+	}
+
+	settingsSchema, err := client.ListDBAASIntegrationSettings(ctx, data.Type.ValueString(), sourceType, destType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read integration settings schema: %w", err)
+	}
+
+	settings, err := validateSettings(data.Settings.ValueString(), settingsSchema.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func readDBAASIntegrationIntoModel(integration *v3.DBAASIntegration, data *IntegrationResourceModel) error {
+	data.ID = types.StringValue(integration.ID.String())
+	data.SourceServiceName = types.StringValue(integration.Source)
+	data.DestServiceName = types.StringValue(integration.Dest)
+	data.Type = types.StringValue(integration.Type)
+	data.Description = types.StringValue(integration.Description)
+	data.Status = types.StringValue(integration.Status)
+	data.IsActive = types.BoolPointerValue(integration.ISActive)
+	data.IsEnabled = types.BoolPointerValue(integration.ISEnabled)
+
+	// Only track the settings keys set by the user, the API also returns defaults.
+	apiSettings := integration.Settings
+	if !data.Settings.IsNull() && !data.Settings.IsUnknown() && data.Settings.ValueString() != "" {
+		var userSettings map[string]any
+		if err := json.Unmarshal([]byte(data.Settings.ValueString()), &userSettings); err != nil {
+			return fmt.Errorf("unable to unmarshal JSON: %w", err)
+		}
+
+		PartialSettingsPatch(userSettings, integration.Settings)
+		apiSettings = userSettings
+	}
+
+	data.Settings = types.StringNull()
+	if apiSettings != nil {
+		settings, err := json.Marshal(apiSettings)
+		if err != nil {
+			return fmt.Errorf("invalid settings: %w", err)
+		}
+		data.Settings = types.StringValue(string(settings))
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package database_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+type TemplateModelIntegration struct {
+	ResourceName string
+
+	SourceServiceName string
+	DestServiceName   string
+	Type              string
+	Zone              string
+	Settings          string
+}
+
+func testResourceIntegration(t *testing.T) {
+	t.Parallel()
+
+	pgTpl, err := template.ParseFiles("testdata/resource_pg.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grafanaTpl, err := template.ParseFiles("testdata/resource_grafana.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	integrationTpl, err := template.ParseFiles("testdata/resource_integration.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pgData := TemplateModelPg{
+		ResourceName:          "source",
+		Name:                  acctest.RandomWithPrefix(testutils.Prefix),
+		Plan:                  "hobbyist-2",
+		Zone:                  testutils.TestZoneName,
+		TerminationProtection: false,
+	}
+	grafanaData := TemplateModelGrafana{
+		ResourceName:          "dest",
+		Name:                  acctest.RandomWithPrefix(testutils.Prefix),
+		Plan:                  "hobbyist-2",
+		Zone:                  testutils.TestZoneName,
+		TerminationProtection: false,
+	}
+
+	fullResourceName := "exoscale_dbaas_integration.test"
+	integrationData := TemplateModelIntegration{
+		ResourceName:      "test",
+		SourceServiceName: "exoscale_dbaas.source.name",
+		DestServiceName:   "exoscale_dbaas.dest.name",
+		Type:              "datasource",
+		Zone:              testutils.TestZoneName,
+	}
+
+	buf := &bytes.Buffer{}
+	if err := pgTpl.Execute(buf, &pgData); err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString("\n")
+	if err := grafanaTpl.Execute(buf, &grafanaData); err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString("\n")
+	if err := integrationTpl.Execute(buf, &integrationData); err != nil {
+		t.Fatal(err)
+	}
+	config := buf.String()
+
+	// integrationID is populated during the Check step so CheckDestroy can use it.
+	var integrationID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testutils.AccPreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			func(_ *terraform.State) error {
+				if integrationID == "" {
+					return nil
+				}
+				return checkIntegrationDestroyed(integrationID)
+			},
+			CheckServiceDestroy("pg", pgData.Name),
+			CheckServiceDestroy("grafana", grafanaData.Name),
+		),
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fullResourceName, "id"),
+					resource.TestCheckResourceAttr(fullResourceName, "source_service_name", pgData.Name),
+					resource.TestCheckResourceAttr(fullResourceName, "dest_service_name", grafanaData.Name),
+					resource.TestCheckResourceAttr(fullResourceName, "type", "datasource"),
+					resource.TestCheckResourceAttr(fullResourceName, "zone", testutils.TestZoneName),
+					resource.TestCheckResourceAttrSet(fullResourceName, "status"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "is_enabled"),
+					// Capture integration ID for CheckDestroy.
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources[fullResourceName]
+						if ok && rs.Primary != nil {
+							integrationID = rs.Primary.Attributes["id"]
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Import
+				ResourceName: fullResourceName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[fullResourceName]
+					if !ok {
+						return "", fmt.Errorf("resource %q not found in state", fullResourceName)
+					}
+					return fmt.Sprintf("%s@%s", rs.Primary.Attributes["id"], testutils.TestZoneName), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkIntegrationDestroyed(integrationID string) error {
+	ctx := context.Background()
+
+	clientV3, err := testutils.APIClientV3()
+	if err != nil {
+		return err
+	}
+
+	client, err := utils.SwitchClientZone(ctx, clientV3, testutils.TestZoneName)
+	if err != nil {
+		return err
+	}
+
+	id, err := v3.ParseUUID(integrationID)
+	if err != nil {
+		return fmt.Errorf("parsing integration ID %q: %w", integrationID, err)
+	}
+
+	_, err = client.GetDBAASIntegration(ctx, id)
+	if err == nil {
+		return fmt.Errorf("integration %q still exists", integrationID)
+	}
+	if errors.Is(err, v3.ErrNotFound) {
+		return nil
+	}
+	return err
+}
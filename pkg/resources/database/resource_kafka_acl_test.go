@@ -0,0 +1,159 @@
+package database_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+type TemplateModelKafkaTopicACL struct {
+	ResourceName string
+
+	Service    string
+	Zone       string
+	Username   string
+	Topic      string
+	Permission string
+}
+
+type TemplateModelKafkaSchemaRegistryACL struct {
+	ResourceName string
+
+	Service    string
+	Zone       string
+	Username   string
+	Resource   string
+	Permission string
+}
+
+func testResourceKafkaACL(t *testing.T) {
+	t.Parallel()
+
+	serviceTpl, err := template.ParseFiles("testdata/resource_kafka.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	topicACLTpl, err := template.ParseFiles("testdata/resource_kafka_topic_acl.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemaRegistryACLTpl, err := template.ParseFiles("testdata/resource_kafka_schema_registry_acl.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serviceData := TemplateModelKafka{
+		ResourceName:          "test",
+		Name:                  acctest.RandomWithPrefix(testutils.Prefix),
+		Plan:                  "business-4",
+		Zone:                  testutils.TestZoneName,
+		TerminationProtection: false,
+		EnableSchemaRegistry:  true,
+	}
+
+	topicACLFullResourceName := "exoscale_dbaas_kafka_topic_acl.test_topic_acl"
+	topicACLData := TemplateModelKafkaTopicACL{
+		ResourceName: "test_topic_acl",
+		Service:      "exoscale_dbaas.test.name",
+		Zone:         testutils.TestZoneName,
+		Username:     "team-a-*",
+		Topic:        "team-a.*",
+		Permission:   "read",
+	}
+
+	schemaRegistryACLFullResourceName := "exoscale_dbaas_kafka_schema_registry_acl.test_schema_registry_acl"
+	schemaRegistryACLData := TemplateModelKafkaSchemaRegistryACL{
+		ResourceName: "test_schema_registry_acl",
+		Service:      "exoscale_dbaas.test.name",
+		Zone:         testutils.TestZoneName,
+		Username:     "team-a-*",
+		Resource:     "Subject:team-a.*",
+		Permission:   "schema_registry_read",
+	}
+
+	render := func(topicACL TemplateModelKafkaTopicACL, schemaRegistryACL TemplateModelKafkaSchemaRegistryACL) string {
+		buf := &bytes.Buffer{}
+		if err := serviceTpl.Execute(buf, &serviceData); err != nil {
+			t.Fatal(err)
+		}
+		buf.WriteString("\n")
+		if err := topicACLTpl.Execute(buf, &topicACL); err != nil {
+			t.Fatal(err)
+		}
+		buf.WriteString("\n")
+		if err := schemaRegistryACLTpl.Execute(buf, &schemaRegistryACL); err != nil {
+			t.Fatal(err)
+		}
+
+		return buf.String()
+	}
+
+	topicACLDataUpdate := topicACLData
+	topicACLDataUpdate.Permission = "readwrite"
+	schemaRegistryACLDataUpdate := schemaRegistryACLData
+	schemaRegistryACLDataUpdate.Permission = "schema_registry_write"
+
+	importStateIDFunc := func(resourceName string) resource.ImportStateIdFunc {
+		return func(s *terraform.State) (string, error) {
+			return fmt.Sprintf("%s@%s", s.RootModule().Resources[resourceName].Primary.ID, testutils.TestZoneName), nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		CheckDestroy:             CheckServiceDestroy("kafka", serviceData.Name),
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: render(topicACLData, schemaRegistryACLData),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(topicACLFullResourceName, "acl_id"),
+					resource.TestCheckResourceAttr(topicACLFullResourceName, "username", topicACLData.Username),
+					resource.TestCheckResourceAttr(topicACLFullResourceName, "topic", topicACLData.Topic),
+					resource.TestCheckResourceAttr(topicACLFullResourceName, "permission", topicACLData.Permission),
+
+					resource.TestCheckResourceAttrSet(schemaRegistryACLFullResourceName, "acl_id"),
+					resource.TestCheckResourceAttr(schemaRegistryACLFullResourceName, "username", schemaRegistryACLData.Username),
+					resource.TestCheckResourceAttr(schemaRegistryACLFullResourceName, "resource", schemaRegistryACLData.Resource),
+					resource.TestCheckResourceAttr(schemaRegistryACLFullResourceName, "permission", schemaRegistryACLData.Permission),
+				),
+			},
+			{
+				// Update permissions (replace)
+				Config: render(topicACLDataUpdate, schemaRegistryACLDataUpdate),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(topicACLFullResourceName, plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction(schemaRegistryACLFullResourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(topicACLFullResourceName, "permission", topicACLDataUpdate.Permission),
+					resource.TestCheckResourceAttr(schemaRegistryACLFullResourceName, "permission", schemaRegistryACLDataUpdate.Permission),
+				),
+			},
+			{
+				// Import
+				ResourceName:      topicACLFullResourceName,
+				ImportStateIdFunc: importStateIDFunc(topicACLFullResourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      schemaRegistryACLFullResourceName,
+				ImportStateIdFunc: importStateIDFunc(schemaRegistryACLFullResourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,331 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type KafkaTopicACLResource struct {
+	client *v3.Client
+}
+
+type KafkaTopicACLResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	ACLID      types.String `tfsdk:"acl_id"`
+	Service    types.String `tfsdk:"service"`
+	Username   types.String `tfsdk:"username"`
+	Topic      types.String `tfsdk:"topic"`
+	Permission types.String `tfsdk:"permission"`
+	Zone       types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+var _ resource.Resource = &KafkaTopicACLResource{}
+var _ resource.ResourceWithImportState = &KafkaTopicACLResource{}
+
+func NewKafkaTopicACLResource() resource.Resource {
+	return &KafkaTopicACLResource{}
+}
+
+func (r *KafkaTopicACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *KafkaTopicACLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_kafka_topic_acl"
+}
+
+func (r *KafkaTopicACLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage topic access control entries for a Kafka Exoscale [Database Services (DBaaS)](https://community.exoscale.com/documentation/dbaas/).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource, computed as `service/acl_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"acl_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the access control entry.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the Kafka database service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "❗ The Kafka username or username pattern (e.g. `team-a-*`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"topic": schema.StringAttribute{
+				MarkdownDescription: "❗ The Kafka topic name or topic pattern (e.g. `team-a.*`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 249),
+				},
+			},
+			"permission": schema.StringAttribute{
+				MarkdownDescription: "❗ The permission granted on matching topics (`admin`, `read`, `readwrite`, `write`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(v3.DBAASKafkaTopicAclEntryPermissionAdmin),
+						string(v3.DBAASKafkaTopicAclEntryPermissionRead),
+						string(v3.DBAASKafkaTopicAclEntryPermissionReadwrite),
+						string(v3.DBAASKafkaTopicAclEntryPermissionWrite),
+					),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *KafkaTopicACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KafkaTopicACLResourceModel
+	ReadResource(ctx, req, resp, &data, r.client)
+}
+
+func (r *KafkaTopicACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KafkaTopicACLResourceModel
+	CreateResource(ctx, req, resp, &data, r.client)
+}
+
+func (r *KafkaTopicACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var stateData, planData KafkaTopicACLResourceModel
+	UpdateResource(ctx, req, resp, &stateData, &planData, r.client)
+}
+
+func (r *KafkaTopicACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KafkaTopicACLResourceModel
+	DeleteResource(ctx, req, resp, &data, r.client)
+}
+
+func (r *KafkaTopicACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	idParts := strings.Split(req.ID, "@")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service/acl_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	aclID := idParts[0]
+	zone := idParts[1]
+	id := strings.Split(aclID, "/")
+	if len(id) != 2 || id[0] == "" || id[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service/acl_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var data KafkaTopicACLResourceModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var resourceTimeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &resourceTimeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Timeouts = resourceTimeouts
+	data.Id = types.StringValue(aclID)
+	data.Service = types.StringValue(id[0])
+	data.ACLID = types.StringValue(id[1])
+	data.Zone = types.StringValue(zone)
+
+	ReadResourceForImport(ctx, req, resp, &data, r.client)
+}
+
+func (data *KafkaTopicACLResourceModel) ReadResource(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) (clearState bool) {
+	acls, err := client.GetDBAASKafkaAclConfig(ctx, data.Service.ValueString())
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return true
+		}
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service kafka topic acl, got error: %s", err))
+		return false
+	}
+
+	for _, acl := range acls.TopicAcl {
+		if string(acl.ID) != data.ACLID.ValueString() {
+			continue
+		}
+
+		data.Username = basetypes.NewStringValue(acl.Username)
+		data.Topic = basetypes.NewStringValue(acl.Topic)
+		data.Permission = basetypes.NewStringValue(string(acl.Permission))
+
+		return false
+	}
+
+	return true
+}
+
+func (data *KafkaTopicACLResourceModel) CreateResource(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) {
+	createRequest := v3.DBAASKafkaTopicAclEntry{
+		Username:   data.Username.ValueString(),
+		Topic:      data.Topic.ValueString(),
+		Permission: v3.DBAASKafkaTopicAclEntryPermission(data.Permission.ValueString()),
+	}
+
+	// Record the existing entries, so that an identical one is not mistaken for the new entry.
+	existing, err := client.GetDBAASKafkaAclConfig(ctx, data.Service.ValueString())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service kafka topic acl, got error: %s", err))
+		return
+	}
+	existingIDs := map[v3.DBAASKafkaAclID]bool{}
+	for _, acl := range existing.TopicAcl {
+		existingIDs[acl.ID] = true
+	}
+
+	op, err := client.CreateDBAASKafkaTopicAclConfig(ctx, data.Service.ValueString(), createRequest)
+	if err != nil {
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create service kafka topic acl, got error %s", err.Error()),
+		)
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create service kafka topic acl, got error %s", err.Error()),
+		)
+		return
+	}
+
+	// The operation does not reference the new entry, look it up by its content among the entries added.
+	acls, err := client.GetDBAASKafkaAclConfig(ctx, data.Service.ValueString())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service kafka topic acl, got error: %s", err))
+		return
+	}
+
+	for _, acl := range acls.TopicAcl {
+		if !existingIDs[acl.ID] &&
+			acl.Username == createRequest.Username &&
+			acl.Topic == createRequest.Topic &&
+			acl.Permission == createRequest.Permission {
+			data.ACLID = basetypes.NewStringValue(string(acl.ID))
+			data.GenerateID()
+			return
+		}
+	}
+
+	diagnostics.AddError("Client Error", "Unable to find newly created topic acl for the service")
+}
+
+func (data *KafkaTopicACLResourceModel) UpdateResource(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) {
+	// All fields are immutable; replaces are triggered automatically.
+}
+
+func (data *KafkaTopicACLResourceModel) DeleteResource(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) {
+	op, err := client.DeleteDBAASKafkaTopicAclConfig(ctx, data.Service.ValueString(), data.ACLID.ValueString())
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return
+		}
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete service kafka topic acl, got error %s", err.Error()),
+		)
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete service kafka topic acl, got error %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (data *KafkaTopicACLResourceModel) WaitForService(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) {
+	_, err := waitForDBAASServiceReadyForFn(ctx, client.GetDBAASServiceKafka, data.Service.ValueString(), func(t *v3.DBAASServiceKafka) bool {
+		return t.State == v3.EnumServiceStateRunning
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Database service Kafka %s", err.Error()))
+	}
+}
+
+func (data *KafkaTopicACLResourceModel) GetTimeouts() timeouts.Value {
+	return data.Timeouts
+}
+
+func (data *KafkaTopicACLResourceModel) SetTimeouts(t timeouts.Value) {
+	data.Timeouts = t
+}
+
+func (data *KafkaTopicACLResourceModel) GetID() basetypes.StringValue {
+	return data.Id
+}
+
+func (data *KafkaTopicACLResourceModel) GetZone() basetypes.StringValue {
+	return data.Zone
+}
+
+func (data *KafkaTopicACLResourceModel) GenerateID() {
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/%s", data.Service.ValueString(), data.ACLID.ValueString()))
+}
@@ -0,0 +1,368 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type OpensearchACLConfigResource struct {
+	client *v3.Client
+}
+
+type OpensearchACLConfigResourceModel struct {
+	Id          types.String                  `tfsdk:"id"`
+	Service     types.String                  `tfsdk:"service"`
+	Zone        types.String                  `tfsdk:"zone"`
+	ACLEnabled  types.Bool                    `tfsdk:"acl_enabled"`
+	ExtendedACL types.Bool                    `tfsdk:"extended_acl"`
+	ACLs        []OpensearchACLConfigACLModel `tfsdk:"acl"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type OpensearchACLConfigACLModel struct {
+	Username types.String                      `tfsdk:"username"`
+	Rules    []OpensearchACLConfigACLRuleModel `tfsdk:"rule"`
+}
+
+type OpensearchACLConfigACLRuleModel struct {
+	Index      types.String `tfsdk:"index"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+var _ resource.Resource = &OpensearchACLConfigResource{}
+var _ resource.ResourceWithImportState = &OpensearchACLConfigResource{}
+
+func NewOpensearchACLConfigResource() resource.Resource {
+	return &OpensearchACLConfigResource{}
+}
+
+func (r *OpensearchACLConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *OpensearchACLConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_opensearch_acl_config"
+}
+
+func (r *OpensearchACLConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage the index-level access control configuration of an OpenSearch Exoscale [Database Services (DBaaS)](https://community.exoscale.com/documentation/dbaas/).
+
+There must be at most one such resource per service: the whole configuration is replaced on each update. Destroying the resource disables the access control and removes all rules.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource, equal to the service name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "❗ The name of the OpenSearch database service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"acl_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable OpenSearch ACLs. When disabled, authenticated service users have unrestricted access.",
+				Required:            true,
+			},
+			"extended_acl": schema.BoolAttribute{
+				MarkdownDescription: "Enforce index rules in a limited fashion for requests that use the `_mget`, `_msearch`, and `_bulk` APIs (default: `false`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"acl": schema.ListNestedAttribute{
+				MarkdownDescription: "Per-user index rules.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The OpenSearch username or username pattern.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 64),
+							},
+						},
+						"rule": schema.ListNestedAttribute{
+							MarkdownDescription: "Index rules, evaluated in order.",
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"index": schema.StringAttribute{
+										MarkdownDescription: "The OpenSearch index name or pattern.",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 249),
+										},
+									},
+									"permission": schema.StringAttribute{
+										MarkdownDescription: "The permission granted on matching indices (`admin`, `read`, `deny`, `readwrite`, `write`).",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(
+												string(v3.EnumOpensearchRulePermissionAdmin),
+												string(v3.EnumOpensearchRulePermissionRead),
+												string(v3.EnumOpensearchRulePermissionDeny),
+												string(v3.EnumOpensearchRulePermissionReadwrite),
+												string(v3.EnumOpensearchRulePermissionWrite),
+											),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *OpensearchACLConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OpensearchACLConfigResourceModel
+	ReadResource(ctx, req, resp, &data, r.client)
+}
+
+func (r *OpensearchACLConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OpensearchACLConfigResourceModel
+	CreateResource(ctx, req, resp, &data, r.client)
+}
+
+func (r *OpensearchACLConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var stateData, planData OpensearchACLConfigResourceModel
+	UpdateResource(ctx, req, resp, &stateData, &planData, r.client)
+}
+
+func (r *OpensearchACLConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OpensearchACLConfigResourceModel
+	DeleteResource(ctx, req, resp, &data, r.client)
+}
+
+func (r *OpensearchACLConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	idParts := strings.Split(req.ID, "@")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var data OpensearchACLConfigResourceModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var resourceTimeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &resourceTimeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Timeouts = resourceTimeouts
+	data.Service = types.StringValue(idParts[0])
+	data.Zone = types.StringValue(idParts[1])
+
+	ReadResourceForImport(ctx, req, resp, &data, r.client)
+}
+
+// toAPI converts the resource model to the API ACL configuration.
+func (data *OpensearchACLConfigResourceModel) toAPI() v3.DBAASOpensearchAclConfig {
+	aclConfig := v3.DBAASOpensearchAclConfig{
+		AclEnabled:         data.ACLEnabled.ValueBoolPointer(),
+		ExtendedAclEnabled: data.ExtendedACL.ValueBoolPointer(),
+		Acls:               []v3.DBAASOpensearchAclConfigAcls{},
+	}
+
+	for _, acl := range data.ACLs {
+		apiACL := v3.DBAASOpensearchAclConfigAcls{
+			Username: v3.DBAASUserUsername(acl.Username.ValueString()),
+		}
+		for _, rule := range acl.Rules {
+			apiACL.Rules = append(apiACL.Rules, v3.DBAASOpensearchAclConfigAclsRules{
+				Index:      rule.Index.ValueString(),
+				Permission: v3.EnumOpensearchRulePermission(rule.Permission.ValueString()),
+			})
+		}
+		aclConfig.Acls = append(aclConfig.Acls, apiACL)
+	}
+
+	return aclConfig
+}
+
+// withEmptyOpensearchACLs returns a client sending an explicit empty acls list, which
+// would otherwise be omitted from the request and leave the remote rules in place.
+func withEmptyOpensearchACLs(client *v3.Client) *v3.Client {
+	return utils.WithJSONBodyFields(client, map[string]any{"acls": []any{}})
+}
+
+// applyAPI populates the resource model from the API ACL configuration.
+func (data *OpensearchACLConfigResourceModel) applyAPI(aclConfig *v3.DBAASOpensearchAclConfig) {
+	data.ACLEnabled = types.BoolValue(aclConfig.AclEnabled != nil && *aclConfig.AclEnabled)
+	data.ExtendedACL = types.BoolValue(aclConfig.ExtendedAclEnabled != nil && *aclConfig.ExtendedAclEnabled)
+
+	// Keep an explicitly empty list as such so it doesn't show as a diff.
+	if len(aclConfig.Acls) == 0 && data.ACLs != nil {
+		data.ACLs = []OpensearchACLConfigACLModel{}
+		return
+	}
+
+	data.ACLs = nil
+	for _, acl := range aclConfig.Acls {
+		aclModel := OpensearchACLConfigACLModel{
+			Username: types.StringValue(string(acl.Username)),
+			Rules:    []OpensearchACLConfigACLRuleModel{},
+		}
+		for _, rule := range acl.Rules {
+			aclModel.Rules = append(aclModel.Rules, OpensearchACLConfigACLRuleModel{
+				Index:      types.StringValue(rule.Index),
+				Permission: types.StringValue(string(rule.Permission)),
+			})
+		}
+		data.ACLs = append(data.ACLs, aclModel)
+	}
+}
+
+func (data *OpensearchACLConfigResourceModel) ReadResource(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) (clearState bool) {
+	aclConfig, err := client.GetDBAASOpensearchAclConfig(ctx, data.Service.ValueString())
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return true
+		}
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service opensearch acl config, got error: %s", err))
+		return false
+	}
+
+	data.applyAPI(aclConfig)
+
+	return false
+}
+
+func (data *OpensearchACLConfigResourceModel) CreateResource(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) {
+	data.UpdateResource(ctx, client, diagnostics)
+}
+
+func (data *OpensearchACLConfigResourceModel) UpdateResource(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) {
+	aclConfig := data.toAPI()
+	updateClient := client
+	if len(aclConfig.Acls) == 0 {
+		updateClient = withEmptyOpensearchACLs(client)
+	}
+
+	op, err := updateClient.UpdateDBAASOpensearchAclConfig(ctx, data.Service.ValueString(), aclConfig)
+	if err != nil {
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update service opensearch acl config, got error %s", err.Error()),
+		)
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to update service opensearch acl config, got error %s", err.Error()),
+		)
+		return
+	}
+
+	data.GenerateID()
+}
+
+func (data *OpensearchACLConfigResourceModel) DeleteResource(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) {
+	op, err := withEmptyOpensearchACLs(client).UpdateDBAASOpensearchAclConfig(ctx, data.Service.ValueString(), v3.DBAASOpensearchAclConfig{
+		AclEnabled:         new(false),
+		ExtendedAclEnabled: new(false),
+		Acls:               []v3.DBAASOpensearchAclConfigAcls{},
+	})
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return
+		}
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to reset service opensearch acl config, got error %s", err.Error()),
+		)
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to reset service opensearch acl config, got error %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (data *OpensearchACLConfigResourceModel) WaitForService(ctx context.Context, client *v3.Client, diagnostics *diag.Diagnostics) {
+	_, err := waitForDBAASServiceReadyForFn(ctx, client.GetDBAASServiceOpensearch, data.Service.ValueString(), func(t *v3.DBAASServiceOpensearch) bool {
+		return t.State == v3.EnumServiceStateRunning
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Database service Opensearch %s", err.Error()))
+	}
+}
+
+func (data *OpensearchACLConfigResourceModel) GetTimeouts() timeouts.Value {
+	return data.Timeouts
+}
+
+func (data *OpensearchACLConfigResourceModel) SetTimeouts(t timeouts.Value) {
+	data.Timeouts = t
+}
+
+func (data *OpensearchACLConfigResourceModel) GetID() basetypes.StringValue {
+	return data.Id
+}
+
+func (data *OpensearchACLConfigResourceModel) GetZone() basetypes.StringValue {
+	return data.Zone
+}
+
+func (data *OpensearchACLConfigResourceModel) GenerateID() {
+	data.Id = basetypes.NewStringValue(data.Service.ValueString())
+}
@@ -0,0 +1,110 @@
+package database
+
+import (
+	"testing"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOpensearchACLConfigResourceModelApplyAPI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reflects remote rules", func(t *testing.T) {
+		t.Parallel()
+
+		data := OpensearchACLConfigResourceModel{
+			ACLs: []OpensearchACLConfigACLModel{
+				{
+					Username: types.StringValue("stale"),
+					Rules: []OpensearchACLConfigACLRuleModel{
+						{Index: types.StringValue("stale-*"), Permission: types.StringValue("read")},
+					},
+				},
+			},
+		}
+
+		data.applyAPI(&v3.DBAASOpensearchAclConfig{
+			AclEnabled: new(true),
+			Acls: []v3.DBAASOpensearchAclConfigAcls{
+				{
+					Username: "team-a",
+					Rules: []v3.DBAASOpensearchAclConfigAclsRules{
+						{Index: "logs-*", Permission: v3.EnumOpensearchRulePermissionRead},
+						{Index: "team-a-*", Permission: v3.EnumOpensearchRulePermissionReadwrite},
+					},
+				},
+			},
+		})
+
+		if !data.ACLEnabled.ValueBool() {
+			t.Fatalf("acl_enabled: got false want true")
+		}
+		if data.ExtendedACL.ValueBool() {
+			t.Fatalf("extended_acl: got true want false")
+		}
+
+		want := []OpensearchACLConfigACLModel{
+			{
+				Username: types.StringValue("team-a"),
+				Rules: []OpensearchACLConfigACLRuleModel{
+					{Index: types.StringValue("logs-*"), Permission: types.StringValue("read")},
+					{Index: types.StringValue("team-a-*"), Permission: types.StringValue("readwrite")},
+				},
+			},
+		}
+		if diff := cmp.Diff(want, data.ACLs); diff != "" {
+			t.Fatalf("acl mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("preserves null and empty rule lists", func(t *testing.T) {
+		t.Parallel()
+
+		null := OpensearchACLConfigResourceModel{}
+		null.applyAPI(&v3.DBAASOpensearchAclConfig{})
+		if null.ACLs != nil {
+			t.Fatalf("acl: got %v want nil", null.ACLs)
+		}
+
+		empty := OpensearchACLConfigResourceModel{ACLs: []OpensearchACLConfigACLModel{}}
+		empty.applyAPI(&v3.DBAASOpensearchAclConfig{})
+		if empty.ACLs == nil || len(empty.ACLs) != 0 {
+			t.Fatalf("acl: got %v want empty list", empty.ACLs)
+		}
+	})
+}
+
+func TestOpensearchACLConfigResourceModelToAPI(t *testing.T) {
+	t.Parallel()
+
+	data := OpensearchACLConfigResourceModel{
+		ACLEnabled:  types.BoolValue(true),
+		ExtendedACL: types.BoolValue(true),
+		ACLs: []OpensearchACLConfigACLModel{
+			{
+				Username: types.StringValue("team-a"),
+				Rules: []OpensearchACLConfigACLRuleModel{
+					{Index: types.StringValue("team-a-*"), Permission: types.StringValue("admin")},
+				},
+			},
+		},
+	}
+
+	want := v3.DBAASOpensearchAclConfig{
+		AclEnabled:         new(true),
+		ExtendedAclEnabled: new(true),
+		Acls: []v3.DBAASOpensearchAclConfigAcls{
+			{
+				Username: "team-a",
+				Rules: []v3.DBAASOpensearchAclConfigAclsRules{
+					{Index: "team-a-*", Permission: v3.EnumOpensearchRulePermissionAdmin},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, data.toAPI()); diff != "" {
+		t.Fatalf("acl config mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -69,6 +69,8 @@ type ServiceResourceModel struct {
 	Kafka      *ResourceKafkaModel      `tfsdk:"kafka"`
 	Opensearch *ResourceOpensearchModel `tfsdk:"opensearch"`
 	Grafana    *ResourceGrafanaModel    `tfsdk:"grafana"`
+	Clickhouse *ResourceClickhouseModel `tfsdk:"clickhouse"`
+	Thanos     *ResourceThanosModel     `tfsdk:"thanos"`
 
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -177,7 +179,7 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Default:             booldefault.StaticBool(true),
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "❗ The type of the database service (`kafka`, `mysql`, `opensearch`, `pg`, `valkey`, `grafana`).",
+				MarkdownDescription: "❗ The type of the database service (`kafka`, `mysql`, `opensearch`, `pg`, `valkey`, `grafana`, `clickhouse`, `thanos`).",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -205,11 +207,13 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 			},
 
+			"clickhouse": ResourceClickhouseSchema,
 			"grafana":    ResourceGrafanaSchema,
 			"kafka":      ResourceKafkaSchema,
 			"mysql":      ResourceMysqlSchema,
 			"opensearch": ResourceOpensearchSchema,
 			"pg":         ResourcePgSchema,
+			"thanos":     ResourceThanosSchema,
 			"valkey":     ResourceValkeySchema,
 		},
 		Blocks: map[string]schema.Block{
@@ -311,6 +315,18 @@ func (r *ServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		grafana.IpFilter = emptyIPFilter
 		normalized.Grafana = &grafana
 	}
+	if normalized.Clickhouse != nil && configData.Clickhouse != nil && configData.Clickhouse.IpFilter.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("clickhouse").AtName("ip_filter"), emptyIPFilter)...)
+		clickhouse := *normalized.Clickhouse
+		clickhouse.IpFilter = emptyIPFilter
+		normalized.Clickhouse = &clickhouse
+	}
+	if normalized.Thanos != nil && configData.Thanos != nil && configData.Thanos.IpFilter.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("thanos").AtName("ip_filter"), emptyIPFilter)...)
+		thanos := *normalized.Thanos
+		thanos.IpFilter = emptyIPFilter
+		normalized.Thanos = &thanos
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -543,6 +559,10 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		r.createOpensearch(ctx, &data, &resp.Diagnostics)
 	case "grafana":
 		r.createGrafana(ctx, &data, &resp.Diagnostics)
+	case "clickhouse":
+		r.createClickhouse(ctx, &data, &resp.Diagnostics)
+	case "thanos":
+		r.createThanos(ctx, &data, &resp.Diagnostics)
 	}
 	if resp.Diagnostics.HasError() {
 		return
@@ -592,6 +612,10 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 		clearState = r.readOpensearch(ctx, &data, &resp.Diagnostics)
 	case "grafana":
 		clearState = r.readGrafana(ctx, &data, &resp.Diagnostics)
+	case "clickhouse":
+		clearState = r.readClickhouse(ctx, &data, &resp.Diagnostics)
+	case "thanos":
+		clearState = r.readThanos(ctx, &data, &resp.Diagnostics)
 	}
 	if resp.Diagnostics.HasError() {
 		return
@@ -644,6 +668,10 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 		r.updateOpensearch(ctx, &stateData, &planData, &resp.Diagnostics)
 	case "grafana":
 		r.updateGrafana(ctx, &stateData, &planData, &resp.Diagnostics)
+	case "clickhouse":
+		r.updateClickhouse(ctx, &stateData, &planData, &resp.Diagnostics)
+	case "thanos":
+		r.updateThanos(ctx, &stateData, &planData, &resp.Diagnostics)
 	}
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,460 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/validators"
+)
+
+type ResourceClickhouseModel struct {
+	IpFilter types.Set    `tfsdk:"ip_filter"`
+	Settings types.String `tfsdk:"clickhouse_settings"`
+	Version  types.String `tfsdk:"version"`
+}
+
+var ResourceClickhouseSchema = schema.SingleNestedAttribute{
+	Optional:            true,
+	MarkdownDescription: "*clickhouse* database service type specific arguments. Structure is documented below.",
+	Attributes: map[string]schema.Attribute{
+		"ip_filter": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "A list of CIDR blocks to allow incoming connections from.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(validators.IsCIDRNetworkValidator{Min: 0, Max: 128}),
+			},
+		},
+		"clickhouse_settings": schema.StringAttribute{
+			MarkdownDescription: "ClickHouse configuration settings in JSON format (`exo dbaas type show clickhouse --settings=clickhouse` for reference).",
+			Optional:            true,
+			Computed:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "ClickHouse major version (`exo dbaas type show clickhouse` for reference).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				versionUseStateUnlessChanged(),
+			},
+		},
+	},
+}
+
+// clickhouseSettingsFromJSON validates user-provided ClickHouse settings against
+// the API JSON schema and converts them to the API settings structure.
+func clickhouseSettingsFromJSON(ctx context.Context, client *v3.Client, in string) (*v3.JSONSchemaClickhouse, error) {
+	settingsSchema, err := client.GetDBAASSettingsClickhouse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read database settings schema: %w", err)
+	}
+
+	var schema any
+	if settingsSchema.Settings != nil {
+		schema = settingsSchema.Settings.Clickhouse
+	}
+
+	if _, err := validateSettings(in, schema); err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+
+	settings := &v3.JSONSchemaClickhouse{}
+	if err := json.Unmarshal([]byte(in), settings); err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// clickhouseSettingsToModel converts API ClickHouse settings to their JSON representation.
+func clickhouseSettingsToModel(settings *v3.JSONSchemaClickhouse) (types.String, error) {
+	if settings == nil {
+		return types.StringNull(), nil
+	}
+
+	b, err := json.Marshal(*settings)
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("invalid settings: %w", err)
+	}
+
+	return types.StringValue(string(b)), nil
+}
+
+// createClickhouse function handles ClickHouse specific part of database resource creation logic.
+func (r *ServiceResource) createClickhouse(ctx context.Context, data *ServiceResourceModel, diagnostics *diag.Diagnostics) {
+	service := v3.CreateDBAASServiceClickhouseRequest{
+		Plan:                  data.Plan.ValueString(),
+		TerminationProtection: data.TerminationProtection.ValueBoolPointer(),
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.clientV3, v3.ZoneName(data.Zone.ValueString()))
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	if !data.MaintenanceDOW.IsUnknown() && !data.MaintenanceTime.IsUnknown() {
+		service.Maintenance = &v3.CreateDBAASServiceClickhouseRequestMaintenance{
+			Dow:  v3.CreateDBAASServiceClickhouseRequestMaintenanceDow(data.MaintenanceDOW.ValueString()),
+			Time: data.MaintenanceTime.ValueString(),
+		}
+	}
+
+	if data.Clickhouse != nil {
+		if !data.Clickhouse.IpFilter.IsUnknown() {
+			obj := []string{}
+			if len(data.Clickhouse.IpFilter.Elements()) > 0 {
+				dg := data.Clickhouse.IpFilter.ElementsAs(ctx, &obj, false)
+				if dg.HasError() {
+					diagnostics.Append(dg...)
+					return
+				}
+			}
+
+			service.IPFilter = obj
+		}
+
+		if !data.Clickhouse.Settings.IsUnknown() {
+			settings, err := clickhouseSettingsFromJSON(ctx, client, data.Clickhouse.Settings.ValueString())
+			if err != nil {
+				diagnostics.AddError("Validation error", err.Error())
+				return
+			}
+			service.ClickhouseSettings = settings
+		}
+
+		if !data.Clickhouse.Version.IsUnknown() {
+			service.Version = data.Clickhouse.Version.ValueString()
+		}
+	}
+
+	_, err = client.CreateDBAASServiceClickhouse(
+		ctx,
+		data.Name.ValueString(),
+		service,
+	)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database service clickhouse, got error: %s", err))
+		return
+	}
+
+	apiService, err := client.GetDBAASServiceClickhouse(ctx, data.Name.ValueString())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database service clickhouse, got error: %s", err))
+		return
+	}
+
+	// Fill in unknown values.
+	caCert, err := client.GetDBAASCACertificate(ctx)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get CA Certificate: %s", err))
+		return
+	}
+
+	data.CA = types.StringValue(caCert.Certificate)
+
+	serviceState := string(apiService.State)
+
+	data.CreatedAt = types.StringValue(apiService.CreatedAT.String())
+	data.DiskSize = types.Int64PointerValue(&apiService.DiskSize)
+	data.NodeCPUs = types.Int64PointerValue(&apiService.NodeCPUCount)
+	data.NodeMemory = types.Int64PointerValue(&apiService.NodeMemory)
+	data.Nodes = types.Int64PointerValue(&apiService.NodeCount)
+	data.State = types.StringPointerValue(&serviceState)
+	data.UpdatedAt = types.StringValue(apiService.UpdatedAT.String())
+
+	uri, err := uriWitoutCreds(&apiService.URI)
+	if err != nil {
+		diagnostics.AddError(err.Error(), "")
+		return
+	}
+	data.URI = types.StringPointerValue(uri)
+
+	if data.TerminationProtection.IsUnknown() {
+		data.TerminationProtection = types.BoolPointerValue(apiService.TerminationProtection)
+	}
+
+	if data.MaintenanceDOW.IsUnknown() || data.MaintenanceTime.IsUnknown() {
+		data.MaintenanceDOW = types.StringNull()
+		data.MaintenanceTime = types.StringNull()
+
+		if apiService.Maintenance != nil {
+			data.MaintenanceDOW = types.StringValue(string(apiService.Maintenance.Dow))
+			data.MaintenanceTime = types.StringValue(apiService.Maintenance.Time)
+		}
+	}
+
+	if data.Clickhouse.IpFilter.IsUnknown() {
+		data.Clickhouse.IpFilter = types.SetNull(types.StringType)
+		if apiService.IPFilter != nil {
+			v, dg := types.SetValueFrom(ctx, types.StringType, apiService.IPFilter)
+			if dg.HasError() {
+				diagnostics.Append(dg...)
+				return
+			}
+			data.Clickhouse.IpFilter = v
+		}
+	}
+
+	if data.Clickhouse.Settings.IsUnknown() {
+		data.Clickhouse.Settings, err = clickhouseSettingsToModel(apiService.ClickhouseSettings)
+		if err != nil {
+			diagnostics.AddError("Validation error", err.Error())
+			return
+		}
+	}
+
+	if data.Clickhouse.Version.IsUnknown() {
+		data.Clickhouse.Version = types.StringNull()
+		if apiService.Version != "" {
+			data.Clickhouse.Version = types.StringValue(apiService.Version)
+		}
+	}
+}
+
+// readClickhouse function handles ClickHouse specific part of database resource Read logic.
+// It is used in the dedicated Read action but also as a finishing step of Create, Update and Import.
+func (r *ServiceResource) readClickhouse(ctx context.Context, data *ServiceResourceModel, diagnostics *diag.Diagnostics) (clearState bool) {
+	client, err := utils.SwitchClientZone(ctx, r.clientV3, v3.ZoneName(data.Zone.ValueString()))
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return false
+	}
+
+	apiService, err := client.GetDBAASServiceClickhouse(ctx, data.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return true
+		}
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database service clickhouse, got error: %s", err))
+		return false
+	}
+
+	caCert, err := client.GetDBAASCACertificate(ctx)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get CA Certificate: %s", err))
+		return false
+	}
+	data.CA = types.StringValue(caCert.Certificate)
+
+	serviceState := string(apiService.State)
+
+	data.CreatedAt = types.StringValue(apiService.CreatedAT.String())
+	data.DiskSize = types.Int64PointerValue(&apiService.DiskSize)
+	data.NodeCPUs = types.Int64PointerValue(&apiService.NodeCPUCount)
+	data.NodeMemory = types.Int64PointerValue(&apiService.NodeMemory)
+	data.Nodes = types.Int64PointerValue(&apiService.NodeCount)
+	data.State = types.StringPointerValue(&serviceState)
+	data.TerminationProtection = types.BoolPointerValue(apiService.TerminationProtection)
+	data.UpdatedAt = types.StringValue(apiService.UpdatedAT.String())
+
+	uri, err := uriWitoutCreds(&apiService.URI)
+	if err != nil {
+		diagnostics.AddError(err.Error(), "")
+		return false
+	}
+	data.URI = types.StringPointerValue(uri)
+
+	data.MaintenanceDOW = types.StringNull()
+	data.MaintenanceTime = types.StringNull()
+	if apiService.Maintenance != nil {
+		data.MaintenanceDOW = types.StringValue(string(apiService.Maintenance.Dow))
+		data.MaintenanceTime = types.StringValue(apiService.Maintenance.Time)
+	}
+
+	// Database block is required but it may be nil during import.
+	if data.Clickhouse == nil {
+		data.Clickhouse = &ResourceClickhouseModel{}
+	}
+
+	data.Clickhouse.IpFilter = types.SetNull(types.StringType)
+	if apiService.IPFilter != nil {
+		v, dg := types.SetValueFrom(ctx, types.StringType, apiService.IPFilter)
+		if dg.HasError() {
+			diagnostics.Append(dg...)
+			return false
+		}
+
+		data.Clickhouse.IpFilter = v
+	}
+
+	data.Clickhouse.Settings, err = clickhouseSettingsToModel(apiService.ClickhouseSettings)
+	if err != nil {
+		diagnostics.AddError("Validation error", err.Error())
+		return false
+	}
+
+	data.Clickhouse.Version = types.StringNull()
+	if apiService.Version != "" {
+		data.Clickhouse.Version = types.StringValue(apiService.Version)
+	}
+
+	return false
+}
+
+// updateClickhouse function handles ClickHouse specific part of database resource Update logic.
+func (r *ServiceResource) updateClickhouse(ctx context.Context, stateData *ServiceResourceModel, planData *ServiceResourceModel, diagnostics *diag.Diagnostics) {
+	var updated bool
+
+	client, err := utils.SwitchClientZone(ctx, r.clientV3, v3.ZoneName(stateData.Zone.ValueString()))
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("couldn't create client error: %s", err))
+		return
+	}
+
+	service := v3.UpdateDBAASServiceClickhouseRequest{}
+
+	if (!planData.MaintenanceDOW.Equal(stateData.MaintenanceDOW) && !planData.MaintenanceDOW.IsUnknown()) ||
+		(!planData.MaintenanceTime.Equal(stateData.MaintenanceTime) && !planData.MaintenanceTime.IsUnknown()) {
+		service.Maintenance = &v3.UpdateDBAASServiceClickhouseRequestMaintenance{
+			Dow:  v3.UpdateDBAASServiceClickhouseRequestMaintenanceDow(planData.MaintenanceDOW.ValueString()),
+			Time: planData.MaintenanceTime.ValueString(),
+		}
+		stateData.MaintenanceDOW = planData.MaintenanceDOW
+		stateData.MaintenanceTime = planData.MaintenanceTime
+		updated = true
+	}
+
+	if !planData.Plan.Equal(stateData.Plan) {
+		service.Plan = planData.Plan.ValueString()
+		stateData.Plan = planData.Plan
+		updated = true
+	}
+
+	if !planData.TerminationProtection.Equal(stateData.TerminationProtection) {
+		service.TerminationProtection = planData.TerminationProtection.ValueBoolPointer()
+		stateData.TerminationProtection = planData.TerminationProtection
+		updated = true
+	}
+
+	if planData.Clickhouse != nil {
+		if stateData.Clickhouse == nil {
+			stateData.Clickhouse = &ResourceClickhouseModel{}
+		}
+
+		if !planData.Clickhouse.IpFilter.Equal(stateData.Clickhouse.IpFilter) {
+			ips := []string{}
+			if len(planData.Clickhouse.IpFilter.Elements()) > 0 {
+				dg := planData.Clickhouse.IpFilter.ElementsAs(ctx, &ips, false)
+				if dg.HasError() {
+					diagnostics.Append(dg...)
+					return
+				}
+			}
+			service.IPFilter = ips
+			stateData.Clickhouse.IpFilter = planData.Clickhouse.IpFilter
+			updated = true
+		}
+
+		if !planData.Clickhouse.Settings.IsUnknown() && !planData.Clickhouse.Settings.Equal(stateData.Clickhouse.Settings) {
+			if planData.Clickhouse.Settings.ValueString() != "" {
+				settings, err := clickhouseSettingsFromJSON(ctx, client, planData.Clickhouse.Settings.ValueString())
+				if err != nil {
+					diagnostics.AddError("Validation error", err.Error())
+					return
+				}
+				service.ClickhouseSettings = settings
+			}
+			stateData.Clickhouse.Settings = planData.Clickhouse.Settings
+			updated = true
+		}
+
+		if !planData.Clickhouse.Version.IsUnknown() && !planData.Clickhouse.Version.Equal(stateData.Clickhouse.Version) {
+			service.Version = planData.Clickhouse.Version.ValueString()
+			stateData.Clickhouse.Version = planData.Clickhouse.Version
+			updated = true
+		}
+	}
+
+	if !updated {
+		tflog.Info(ctx, "no updates detected", map[string]any{})
+		return
+	}
+
+	if _, err := client.UpdateDBAASServiceClickhouse(
+		ctx,
+		planData.Id.ValueString(),
+		service,
+	); err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update database service clickhouse, got error: %s", err))
+		return
+	}
+
+	// Get the current state after update
+	apiService, err := client.GetDBAASServiceClickhouse(ctx, planData.Id.ValueString())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database service clickhouse, got error: %s", err))
+		return
+	}
+
+	// Fill in unknown values.
+	stateData.State = types.StringValue(string(apiService.State))
+	stateData.NodeCPUs = types.Int64PointerValue(&apiService.NodeCPUCount)
+	stateData.Nodes = types.Int64PointerValue(&apiService.NodeCount)
+	stateData.NodeMemory = types.Int64PointerValue(&apiService.NodeMemory)
+	stateData.UpdatedAt = types.StringValue(apiService.UpdatedAT.String())
+	stateData.TerminationProtection = types.BoolPointerValue(apiService.TerminationProtection)
+	uri, err := uriWitoutCreds(&apiService.URI)
+	if err != nil {
+		diagnostics.AddError(err.Error(), "")
+		return
+	}
+	stateData.URI = types.StringPointerValue(uri)
+	if apiService.Maintenance != nil {
+		if !stateData.MaintenanceDOW.IsUnknown() {
+			stateData.MaintenanceDOW = types.StringValue(string(apiService.Maintenance.Dow))
+		}
+		if !stateData.MaintenanceTime.IsUnknown() {
+			stateData.MaintenanceTime = types.StringValue(apiService.Maintenance.Time)
+		}
+	} else {
+		if !stateData.MaintenanceDOW.IsUnknown() {
+			stateData.MaintenanceDOW = types.StringNull()
+		}
+		if !stateData.MaintenanceTime.IsUnknown() {
+			stateData.MaintenanceTime = types.StringNull()
+		}
+	}
+
+	if stateData.Clickhouse == nil {
+		return
+	}
+
+	if stateData.Clickhouse.IpFilter.IsUnknown() {
+		stateData.Clickhouse.IpFilter = types.SetNull(types.StringType)
+		if apiService.IPFilter != nil {
+			v, dg := types.SetValueFrom(ctx, types.StringType, apiService.IPFilter)
+			if dg.HasError() {
+				diagnostics.Append(dg...)
+				return
+			}
+			stateData.Clickhouse.IpFilter = v
+		}
+	}
+	if stateData.Clickhouse.Settings.IsUnknown() {
+		stateData.Clickhouse.Settings, err = clickhouseSettingsToModel(apiService.ClickhouseSettings)
+		if err != nil {
+			diagnostics.AddError("Validation error", err.Error())
+			return
+		}
+	}
+	if stateData.Clickhouse.Version.IsUnknown() {
+		stateData.Clickhouse.Version = types.StringNull()
+		if apiService.Version != "" {
+			stateData.Clickhouse.Version = types.StringValue(apiService.Version)
+		}
+	}
+}
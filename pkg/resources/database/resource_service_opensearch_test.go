@@ -21,6 +21,7 @@ import (
 	"github.com/exoscale/egoscale/v2/oapi"
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 type TemplateModelOpensearch struct {
@@ -71,6 +72,27 @@ type TemplateModelOpensearchUser struct {
 	Zone     string
 }
 
+type TemplateModelOpensearchACLConfig struct {
+	ResourceName string
+
+	Service string
+	Zone    string
+
+	ACLEnabled  bool
+	ExtendedACL bool
+	ACLs        []TemplateModelOpensearchACL
+}
+
+type TemplateModelOpensearchACL struct {
+	Username string
+	Rules    []TemplateModelOpensearchACLRule
+}
+
+type TemplateModelOpensearchACLRule struct {
+	Index      string
+	Permission string
+}
+
 func testResourceOpensearch(t *testing.T) {
 	t.Parallel()
 
@@ -82,6 +104,10 @@ func testResourceOpensearch(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	aclConfigTpl, err := template.ParseFiles("testdata/resource_opensearch_acl_config.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	serviceFullResourceName := "exoscale_dbaas.test"
 	serviceDataBase := TemplateModelOpensearch{
@@ -101,6 +127,14 @@ func testResourceOpensearch(t *testing.T) {
 		Service:      fmt.Sprintf("%s.name", serviceFullResourceName),
 	}
 
+	aclConfigFullResourceName := "exoscale_dbaas_opensearch_acl_config.test_acl_config"
+	aclConfigDataBase := TemplateModelOpensearchACLConfig{
+		ResourceName: "test_acl_config",
+		Zone:         serviceDataBase.Zone,
+		Service:      fmt.Sprintf("%s.name", serviceFullResourceName),
+		ACLEnabled:   true,
+	}
+
 	serviceDataCreate := serviceDataBase
 	serviceDataCreate.MaintenanceDow = "monday"
 	serviceDataCreate.MaintenanceTime = "01:23:00"
@@ -115,6 +149,11 @@ func testResourceOpensearch(t *testing.T) {
 
 	userDataCreate := userDataBase
 
+	aclConfigDataCreate := aclConfigDataBase
+	aclConfigDataCreate.ACLs = []TemplateModelOpensearchACL{
+		{userDataCreate.Username, []TemplateModelOpensearchACLRule{{"log.*", "read"}}},
+	}
+
 	buf := &bytes.Buffer{}
 	err = serviceTpl.Execute(buf, &serviceDataCreate)
 	if err != nil {
@@ -124,6 +163,10 @@ func testResourceOpensearch(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	err = aclConfigTpl.Execute(buf, &aclConfigDataCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
 	configCreate := buf.String()
 
 	serviceDataUpdate := serviceDataBase
@@ -142,6 +185,12 @@ func testResourceOpensearch(t *testing.T) {
 	userDataUpdate := userDataBase
 	userDataUpdate.Username = "bar"
 
+	aclConfigDataUpdate := aclConfigDataBase
+	aclConfigDataUpdate.ExtendedACL = true
+	aclConfigDataUpdate.ACLs = []TemplateModelOpensearchACL{
+		{userDataUpdate.Username, []TemplateModelOpensearchACLRule{{"log.*", "readwrite"}, {"internet.*", "deny"}}},
+	}
+
 	buf = &bytes.Buffer{}
 	err = serviceTpl.Execute(buf, &serviceDataUpdate)
 	if err != nil {
@@ -151,8 +200,30 @@ func testResourceOpensearch(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	err = aclConfigTpl.Execute(buf, &aclConfigDataUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
 	configUpdate := buf.String()
 
+	aclConfigDataClear := aclConfigDataUpdate
+	aclConfigDataClear.ACLs = nil
+
+	buf = &bytes.Buffer{}
+	err = serviceTpl.Execute(buf, &serviceDataUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = userTpl.Execute(buf, &userDataUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = aclConfigTpl.Execute(buf, &aclConfigDataClear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configClearACLs := buf.String()
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testutils.AccPreCheck(t) },
 		CheckDestroy:             CheckServiceDestroy("opensearch", serviceDataBase.Name),
@@ -191,6 +262,12 @@ func testResourceOpensearch(t *testing.T) {
 
 						return nil
 					},
+					// ACL config
+					resource.TestCheckResourceAttr(aclConfigFullResourceName, "acl_enabled", "true"),
+					resource.TestCheckResourceAttr(aclConfigFullResourceName, "extended_acl", "false"),
+					resource.TestCheckResourceAttr(aclConfigFullResourceName, "acl.#", "1"),
+					resource.TestCheckResourceAttr(aclConfigFullResourceName, "acl.0.username", userDataCreate.Username),
+					resource.TestCheckResourceAttr(aclConfigFullResourceName, "acl.0.rule.0.permission", "read"),
 				),
 			},
 			{
@@ -225,6 +302,15 @@ func testResourceOpensearch(t *testing.T) {
 
 						return nil
 					},
+
+					// ACL config
+					resource.TestCheckResourceAttr(aclConfigFullResourceName, "extended_acl", "true"),
+					resource.TestCheckResourceAttr(aclConfigFullResourceName, "acl.0.username", userDataUpdate.Username),
+					resource.TestCheckResourceAttr(aclConfigFullResourceName, "acl.0.rule.#", "2"),
+					resource.TestCheckResourceAttr(aclConfigFullResourceName, "acl.0.rule.1.permission", "deny"),
+					func(s *terraform.State) error {
+						return CheckExistsOpensearchACLConfig(serviceDataBase.Name, &aclConfigDataUpdate)
+					},
 				),
 			},
 			{
@@ -249,6 +335,26 @@ func testResourceOpensearch(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName: aclConfigFullResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(*terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", serviceDataBase.Name, aclConfigDataBase.Zone), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Remove all ACLs
+				Config: configClearACLs,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(aclConfigFullResourceName, "acl.#"),
+					func(s *terraform.State) error {
+						return CheckExistsOpensearchACLConfig(serviceDataBase.Name, &aclConfigDataClear)
+					},
+				),
+			},
 		},
 	})
 }
@@ -379,3 +485,49 @@ func CheckExistsOpensearchUser(service, username string, data *TemplateModelOpen
 
 	return fmt.Errorf("could not find user %s for service %s, found %v", username, service, serviceUsernames)
 }
+
+func CheckExistsOpensearchACLConfig(service string, data *TemplateModelOpensearchACLConfig) error {
+	ctx := context.Background()
+
+	defaultClientV3, err := testutils.APIClientV3()
+	if err != nil {
+		return err
+	}
+
+	client, err := utils.SwitchClientZone(
+		ctx,
+		defaultClientV3,
+		testutils.TestZoneName,
+	)
+	if err != nil {
+		return err
+	}
+
+	aclConfig, err := client.GetDBAASOpensearchAclConfig(ctx, service)
+	if err != nil {
+		return err
+	}
+
+	if v := aclConfig.AclEnabled != nil && *aclConfig.AclEnabled; v != data.ACLEnabled {
+		return fmt.Errorf("acl_enabled: expected %v, got %v", data.ACLEnabled, v)
+	}
+
+	if v := aclConfig.ExtendedAclEnabled != nil && *aclConfig.ExtendedAclEnabled; v != data.ExtendedACL {
+		return fmt.Errorf("extended_acl: expected %v, got %v", data.ExtendedACL, v)
+	}
+
+	actual := make([]TemplateModelOpensearchACL, 0, len(aclConfig.Acls))
+	for _, acl := range aclConfig.Acls {
+		rules := make([]TemplateModelOpensearchACLRule, 0, len(acl.Rules))
+		for _, rule := range acl.Rules {
+			rules = append(rules, TemplateModelOpensearchACLRule{rule.Index, string(rule.Permission)})
+		}
+		actual = append(actual, TemplateModelOpensearchACL{string(acl.Username), rules})
+	}
+
+	if !cmp.Equal(data.ACLs, actual, cmpopts.EquateEmpty()) {
+		return fmt.Errorf("acl: expected %v, got %v", data.ACLs, actual)
+	}
+
+	return nil
+}
@@ -0,0 +1,422 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/validators"
+)
+
+type ResourceThanosModel struct {
+	IpFilter types.Set    `tfsdk:"ip_filter"`
+	Settings types.String `tfsdk:"thanos_settings"`
+}
+
+var ResourceThanosSchema = schema.SingleNestedAttribute{
+	Optional:            true,
+	MarkdownDescription: "*thanos* database service type specific arguments. Structure is documented below.",
+	Attributes: map[string]schema.Attribute{
+		"ip_filter": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "A list of CIDR blocks to allow incoming connections from.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(validators.IsCIDRNetworkValidator{Min: 0, Max: 128}),
+			},
+		},
+		"thanos_settings": schema.StringAttribute{
+			MarkdownDescription: "Thanos configuration settings in JSON format (`exo dbaas type show thanos --settings=thanos` for reference).",
+			Optional:            true,
+			Computed:            true,
+		},
+	},
+}
+
+// thanosSettingsFromJSON validates user-provided Thanos settings against
+// the API JSON schema and converts them to the API settings structure.
+func thanosSettingsFromJSON(ctx context.Context, client *v3.Client, in string) (*v3.JSONSchemaThanos, error) {
+	settingsSchema, err := client.GetDBAASSettingsThanos(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read database settings schema: %w", err)
+	}
+
+	var schema any
+	if settingsSchema.Settings != nil {
+		schema = settingsSchema.Settings.Thanos
+	}
+
+	if _, err := validateSettings(in, schema); err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+
+	settings := &v3.JSONSchemaThanos{}
+	if err := json.Unmarshal([]byte(in), settings); err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// thanosSettingsToModel converts API Thanos settings to their JSON representation.
+func thanosSettingsToModel(settings *v3.JSONSchemaThanos) (types.String, error) {
+	if settings == nil {
+		return types.StringNull(), nil
+	}
+
+	b, err := json.Marshal(*settings)
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("invalid settings: %w", err)
+	}
+
+	return types.StringValue(string(b)), nil
+}
+
+// createThanos function handles Thanos specific part of database resource creation logic.
+func (r *ServiceResource) createThanos(ctx context.Context, data *ServiceResourceModel, diagnostics *diag.Diagnostics) {
+	service := v3.CreateDBAASServiceThanosRequest{
+		Plan:                  data.Plan.ValueString(),
+		TerminationProtection: data.TerminationProtection.ValueBoolPointer(),
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.clientV3, v3.ZoneName(data.Zone.ValueString()))
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	if !data.MaintenanceDOW.IsUnknown() && !data.MaintenanceTime.IsUnknown() {
+		service.Maintenance = &v3.CreateDBAASServiceThanosRequestMaintenance{
+			Dow:  v3.CreateDBAASServiceThanosRequestMaintenanceDow(data.MaintenanceDOW.ValueString()),
+			Time: data.MaintenanceTime.ValueString(),
+		}
+	}
+
+	if data.Thanos != nil {
+		if !data.Thanos.IpFilter.IsUnknown() {
+			obj := []string{}
+			if len(data.Thanos.IpFilter.Elements()) > 0 {
+				dg := data.Thanos.IpFilter.ElementsAs(ctx, &obj, false)
+				if dg.HasError() {
+					diagnostics.Append(dg...)
+					return
+				}
+			}
+
+			service.IPFilter = obj
+		}
+
+		if !data.Thanos.Settings.IsUnknown() {
+			settings, err := thanosSettingsFromJSON(ctx, client, data.Thanos.Settings.ValueString())
+			if err != nil {
+				diagnostics.AddError("Validation error", err.Error())
+				return
+			}
+			service.ThanosSettings = settings
+		}
+	}
+
+	_, err = client.CreateDBAASServiceThanos(
+		ctx,
+		data.Name.ValueString(),
+		service,
+	)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database service thanos, got error: %s", err))
+		return
+	}
+
+	apiService, err := client.GetDBAASServiceThanos(ctx, data.Name.ValueString())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database service thanos, got error: %s", err))
+		return
+	}
+
+	// Fill in unknown values.
+	caCert, err := client.GetDBAASCACertificate(ctx)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get CA Certificate: %s", err))
+		return
+	}
+
+	data.CA = types.StringValue(caCert.Certificate)
+
+	serviceState := string(apiService.State)
+
+	data.CreatedAt = types.StringValue(apiService.CreatedAT.String())
+	data.DiskSize = types.Int64PointerValue(&apiService.DiskSize)
+	data.NodeCPUs = types.Int64PointerValue(&apiService.NodeCPUCount)
+	data.NodeMemory = types.Int64PointerValue(&apiService.NodeMemory)
+	data.Nodes = types.Int64PointerValue(&apiService.NodeCount)
+	data.State = types.StringPointerValue(&serviceState)
+	data.UpdatedAt = types.StringValue(apiService.UpdatedAT.String())
+
+	uri, err := uriWitoutCreds(&apiService.URI)
+	if err != nil {
+		diagnostics.AddError(err.Error(), "")
+		return
+	}
+	data.URI = types.StringPointerValue(uri)
+
+	if data.TerminationProtection.IsUnknown() {
+		data.TerminationProtection = types.BoolPointerValue(apiService.TerminationProtection)
+	}
+
+	if data.MaintenanceDOW.IsUnknown() || data.MaintenanceTime.IsUnknown() {
+		data.MaintenanceDOW = types.StringNull()
+		data.MaintenanceTime = types.StringNull()
+
+		if apiService.Maintenance != nil {
+			data.MaintenanceDOW = types.StringValue(string(apiService.Maintenance.Dow))
+			data.MaintenanceTime = types.StringValue(apiService.Maintenance.Time)
+		}
+	}
+
+	if data.Thanos.IpFilter.IsUnknown() {
+		data.Thanos.IpFilter = types.SetNull(types.StringType)
+		if apiService.IPFilter != nil {
+			v, dg := types.SetValueFrom(ctx, types.StringType, apiService.IPFilter)
+			if dg.HasError() {
+				diagnostics.Append(dg...)
+				return
+			}
+			data.Thanos.IpFilter = v
+		}
+	}
+
+	if data.Thanos.Settings.IsUnknown() {
+		data.Thanos.Settings, err = thanosSettingsToModel(apiService.ThanosSettings)
+		if err != nil {
+			diagnostics.AddError("Validation error", err.Error())
+			return
+		}
+	}
+}
+
+// readThanos function handles Thanos specific part of database resource Read logic.
+// It is used in the dedicated Read action but also as a finishing step of Create, Update and Import.
+func (r *ServiceResource) readThanos(ctx context.Context, data *ServiceResourceModel, diagnostics *diag.Diagnostics) (clearState bool) {
+	client, err := utils.SwitchClientZone(ctx, r.clientV3, v3.ZoneName(data.Zone.ValueString()))
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return false
+	}
+
+	apiService, err := client.GetDBAASServiceThanos(ctx, data.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return true
+		}
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database service thanos, got error: %s", err))
+		return false
+	}
+
+	caCert, err := client.GetDBAASCACertificate(ctx)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get CA Certificate: %s", err))
+		return false
+	}
+	data.CA = types.StringValue(caCert.Certificate)
+
+	serviceState := string(apiService.State)
+
+	data.CreatedAt = types.StringValue(apiService.CreatedAT.String())
+	data.DiskSize = types.Int64PointerValue(&apiService.DiskSize)
+	data.NodeCPUs = types.Int64PointerValue(&apiService.NodeCPUCount)
+	data.NodeMemory = types.Int64PointerValue(&apiService.NodeMemory)
+	data.Nodes = types.Int64PointerValue(&apiService.NodeCount)
+	data.State = types.StringPointerValue(&serviceState)
+	data.TerminationProtection = types.BoolPointerValue(apiService.TerminationProtection)
+	data.UpdatedAt = types.StringValue(apiService.UpdatedAT.String())
+
+	uri, err := uriWitoutCreds(&apiService.URI)
+	if err != nil {
+		diagnostics.AddError(err.Error(), "")
+		return false
+	}
+	data.URI = types.StringPointerValue(uri)
+
+	data.MaintenanceDOW = types.StringNull()
+	data.MaintenanceTime = types.StringNull()
+	if apiService.Maintenance != nil {
+		data.MaintenanceDOW = types.StringValue(string(apiService.Maintenance.Dow))
+		data.MaintenanceTime = types.StringValue(apiService.Maintenance.Time)
+	}
+
+	// Database block is required but it may be nil during import.
+	if data.Thanos == nil {
+		data.Thanos = &ResourceThanosModel{}
+	}
+
+	data.Thanos.IpFilter = types.SetNull(types.StringType)
+	if apiService.IPFilter != nil {
+		v, dg := types.SetValueFrom(ctx, types.StringType, apiService.IPFilter)
+		if dg.HasError() {
+			diagnostics.Append(dg...)
+			return false
+		}
+
+		data.Thanos.IpFilter = v
+	}
+
+	data.Thanos.Settings, err = thanosSettingsToModel(apiService.ThanosSettings)
+	if err != nil {
+		diagnostics.AddError("Validation error", err.Error())
+		return false
+	}
+
+	return false
+}
+
+// updateThanos function handles Thanos specific part of database resource Update logic.
+func (r *ServiceResource) updateThanos(ctx context.Context, stateData *ServiceResourceModel, planData *ServiceResourceModel, diagnostics *diag.Diagnostics) {
+	var updated bool
+
+	client, err := utils.SwitchClientZone(ctx, r.clientV3, v3.ZoneName(stateData.Zone.ValueString()))
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("couldn't create client error: %s", err))
+		return
+	}
+
+	service := v3.UpdateDBAASServiceThanosRequest{}
+
+	if (!planData.MaintenanceDOW.Equal(stateData.MaintenanceDOW) && !planData.MaintenanceDOW.IsUnknown()) ||
+		(!planData.MaintenanceTime.Equal(stateData.MaintenanceTime) && !planData.MaintenanceTime.IsUnknown()) {
+		service.Maintenance = &v3.UpdateDBAASServiceThanosRequestMaintenance{
+			Dow:  v3.UpdateDBAASServiceThanosRequestMaintenanceDow(planData.MaintenanceDOW.ValueString()),
+			Time: planData.MaintenanceTime.ValueString(),
+		}
+		stateData.MaintenanceDOW = planData.MaintenanceDOW
+		stateData.MaintenanceTime = planData.MaintenanceTime
+		updated = true
+	}
+
+	if !planData.Plan.Equal(stateData.Plan) {
+		service.Plan = planData.Plan.ValueString()
+		stateData.Plan = planData.Plan
+		updated = true
+	}
+
+	if !planData.TerminationProtection.Equal(stateData.TerminationProtection) {
+		service.TerminationProtection = planData.TerminationProtection.ValueBoolPointer()
+		stateData.TerminationProtection = planData.TerminationProtection
+		updated = true
+	}
+
+	if planData.Thanos != nil {
+		if stateData.Thanos == nil {
+			stateData.Thanos = &ResourceThanosModel{}
+		}
+
+		if !planData.Thanos.IpFilter.Equal(stateData.Thanos.IpFilter) {
+			ips := []string{}
+			if len(planData.Thanos.IpFilter.Elements()) > 0 {
+				dg := planData.Thanos.IpFilter.ElementsAs(ctx, &ips, false)
+				if dg.HasError() {
+					diagnostics.Append(dg...)
+					return
+				}
+			}
+			service.IPFilter = ips
+			stateData.Thanos.IpFilter = planData.Thanos.IpFilter
+			updated = true
+		}
+
+		if !planData.Thanos.Settings.IsUnknown() && !planData.Thanos.Settings.Equal(stateData.Thanos.Settings) {
+			if planData.Thanos.Settings.ValueString() != "" {
+				settings, err := thanosSettingsFromJSON(ctx, client, planData.Thanos.Settings.ValueString())
+				if err != nil {
+					diagnostics.AddError("Validation error", err.Error())
+					return
+				}
+				service.ThanosSettings = settings
+			}
+			stateData.Thanos.Settings = planData.Thanos.Settings
+			updated = true
+		}
+	}
+
+	if !updated {
+		tflog.Info(ctx, "no updates detected", map[string]any{})
+		return
+	}
+
+	if _, err := client.UpdateDBAASServiceThanos(
+		ctx,
+		planData.Id.ValueString(),
+		service,
+	); err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update database service thanos, got error: %s", err))
+		return
+	}
+
+	// Get the current state after update
+	apiService, err := client.GetDBAASServiceThanos(ctx, planData.Id.ValueString())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database service thanos, got error: %s", err))
+		return
+	}
+
+	// Fill in unknown values.
+	stateData.State = types.StringValue(string(apiService.State))
+	stateData.NodeCPUs = types.Int64PointerValue(&apiService.NodeCPUCount)
+	stateData.Nodes = types.Int64PointerValue(&apiService.NodeCount)
+	stateData.NodeMemory = types.Int64PointerValue(&apiService.NodeMemory)
+	stateData.UpdatedAt = types.StringValue(apiService.UpdatedAT.String())
+	stateData.TerminationProtection = types.BoolPointerValue(apiService.TerminationProtection)
+	uri, err := uriWitoutCreds(&apiService.URI)
+	if err != nil {
+		diagnostics.AddError(err.Error(), "")
+		return
+	}
+	stateData.URI = types.StringPointerValue(uri)
+	if apiService.Maintenance != nil {
+		if !stateData.MaintenanceDOW.IsUnknown() {
+			stateData.MaintenanceDOW = types.StringValue(string(apiService.Maintenance.Dow))
+		}
+		if !stateData.MaintenanceTime.IsUnknown() {
+			stateData.MaintenanceTime = types.StringValue(apiService.Maintenance.Time)
+		}
+	} else {
+		if !stateData.MaintenanceDOW.IsUnknown() {
+			stateData.MaintenanceDOW = types.StringNull()
+		}
+		if !stateData.MaintenanceTime.IsUnknown() {
+			stateData.MaintenanceTime = types.StringNull()
+		}
+	}
+
+	if stateData.Thanos == nil {
+		return
+	}
+
+	if stateData.Thanos.IpFilter.IsUnknown() {
+		stateData.Thanos.IpFilter = types.SetNull(types.StringType)
+		if apiService.IPFilter != nil {
+			v, dg := types.SetValueFrom(ctx, types.StringType, apiService.IPFilter)
+			if dg.HasError() {
+				diagnostics.Append(dg...)
+				return
+			}
+			stateData.Thanos.IpFilter = v
+		}
+	}
+	if stateData.Thanos.Settings.IsUnknown() {
+		stateData.Thanos.Settings, err = thanosSettingsToModel(apiService.ThanosSettings)
+		if err != nil {
+			diagnostics.AddError("Validation error", err.Error())
+			return
+		}
+	}
+}
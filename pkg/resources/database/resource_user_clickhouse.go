@@ -0,0 +1,229 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.Resource = &ClickhouseUserResource{}
+var _ resource.ResourceWithImportState = &ClickhouseUserResource{}
+
+func NewClickhouseUserResource() resource.Resource {
+	return &ClickhouseUserResource{}
+}
+
+type ClickhouseUserResource struct {
+	UserResource
+}
+
+type ClickhouseUserResourceModel struct {
+	UserResourceModel
+}
+
+func (r *ClickhouseUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *ClickhouseUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbaas_clickhouse_user"
+}
+
+func (r *ClickhouseUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage service users for a ClickHouse Exoscale [Database Services (DBaaS)](https://community.exoscale.com/documentation/dbaas/).",
+		Attributes:          commonAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ClickhouseUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClickhouseUserResourceModel
+	ReadResource(ctx, req, resp, &data, r.client)
+}
+
+func (r *ClickhouseUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClickhouseUserResourceModel
+	CreateResource(ctx, req, resp, &data, r.client)
+}
+
+func (r *ClickhouseUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var stateData, planData ClickhouseUserResourceModel
+	UpdateResource(ctx, req, resp, &stateData, &planData, r.client)
+}
+
+func (r *ClickhouseUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClickhouseUserResourceModel
+	DeleteResource(ctx, req, resp, &data, r.client)
+}
+
+func (r *ClickhouseUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service/username@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	userID := idParts[0]
+	zone := idParts[1]
+
+	id := strings.Split(userID, "/")
+
+	if len(id) != 2 || id[0] == "" || id[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service/username@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	serviceName := id[0]
+	username := id[1]
+
+	var data ClickhouseUserResourceModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Timeouts = timeouts
+
+	data.Id = types.StringValue(userID)
+	data.Username = types.StringValue(username)
+	data.Service = types.StringValue(serviceName)
+	data.Zone = types.StringValue(zone)
+
+	ReadResourceForImport(ctx, req, resp, &data, r.client)
+}
+
+func (data *ClickhouseUserResourceModel) CreateResource(ctx context.Context, client *exoscale.Client, diagnostics *diag.Diagnostics) {
+
+	createRequest := exoscale.CreateDBAASClickhouseUserRequest{
+		Username: exoscale.DBAASUserUsername(data.Username.ValueString()),
+	}
+
+	secrets, err := client.CreateDBAASClickhouseUser(ctx, data.Service.ValueString(), createRequest)
+	if err != nil {
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create service user, got error %s", err.Error()),
+		)
+		return
+	}
+
+	// ClickHouse users have no type.
+	data.Type = basetypes.NewStringNull()
+	data.Password = basetypes.NewStringValue(secrets.Password)
+}
+
+func (data *ClickhouseUserResourceModel) DeleteResource(ctx context.Context, client *exoscale.Client, diagnostics *diag.Diagnostics) {
+
+	op, err := client.DeleteDBAASClickhouseUser(ctx, data.Service.ValueString(), data.Username.ValueString())
+	if err != nil {
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete service user, got error %s", err.Error()),
+		)
+		return
+	}
+
+	_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete service user, got error %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (data *ClickhouseUserResourceModel) ReadResource(ctx context.Context, client *exoscale.Client, diagnostics *diag.Diagnostics) (clearState bool) {
+
+	users, err := client.ListDBAASClickhouseUsers(ctx, data.Service.ValueString())
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return true
+		}
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service clickhouse user, got error: %s", err))
+		return false
+	}
+
+	for _, user := range users.Users {
+		if string(user.Username) == data.Username.ValueString() {
+			data.Type = basetypes.NewStringNull()
+
+			pass, err := client.RevealDBAASClickhouseUserPassword(ctx, data.Service.ValueString(), data.Username.ValueString())
+			if err != nil {
+				diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reveal clickhouse user password, got error: %s", err))
+				return false
+			}
+
+			data.Password = basetypes.NewStringValue(pass.Password)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+func (data *ClickhouseUserResourceModel) UpdateResource(ctx context.Context, client *exoscale.Client, diagnostics *diag.Diagnostics) {
+	// All fields are immutable; replaces are triggered automatically.
+}
+
+func (data *ClickhouseUserResourceModel) WaitForService(ctx context.Context, client *exoscale.Client, diagnostics *diag.Diagnostics) {
+	_, err := waitForDBAASServiceReadyForFn(ctx, client.GetDBAASServiceClickhouse, data.Service.ValueString(), func(t *exoscale.DBAASServiceClickhouse) bool {
+		return t.State == exoscale.EnumServiceStateRunning && len(t.Users) > 0
+	})
+
+	time.Sleep(SERVICE_READY_DELAY)
+
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Database service ClickHouse %s", err.Error()))
+	}
+}
+
+func (data *ClickhouseUserResourceModel) GetTimeouts() timeouts.Value {
+	return data.Timeouts
+}
+
+func (data *ClickhouseUserResourceModel) SetTimeouts(t timeouts.Value) {
+	data.Timeouts = t
+}
+
+func (data *ClickhouseUserResourceModel) GetID() basetypes.StringValue {
+	return data.Id
+}
+
+func (data *ClickhouseUserResourceModel) GetZone() basetypes.StringValue {
+	return data.Zone
+}
+
+func (data *ClickhouseUserResourceModel) GenerateID() {
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/%s", data.Service.ValueString(), data.Username.ValueString()))
+}
@@ -0,0 +1,92 @@
+package database_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+type TemplateModelClickhouseUser struct {
+	ResourceName string
+	Username     string
+	Service      string
+	Zone         string
+}
+
+func testResourceClickhouseUser(t *testing.T) {
+	t.Parallel()
+
+	svcTpl, err := template.ParseFiles("testdata/resource_clickhouse.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userTpl, err := template.ParseFiles("testdata/resource_user_clickhouse.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svcData := TemplateModelClickhouse{
+		ResourceName:          "test",
+		Name:                  acctest.RandomWithPrefix(testutils.Prefix),
+		Plan:                  "startup-16",
+		Zone:                  testutils.TestZoneName,
+		TerminationProtection: false,
+	}
+
+	userData := TemplateModelClickhouseUser{
+		ResourceName: "test_user",
+		Username:     acctest.RandomWithPrefix(testutils.TestUsername),
+		Service:      "exoscale_database.test.name",
+		Zone:         testutils.TestZoneName,
+	}
+
+	svcBuf := &bytes.Buffer{}
+	if err = svcTpl.Execute(svcBuf, &svcData); err != nil {
+		t.Fatal(err)
+	}
+
+	userBuf := &bytes.Buffer{}
+	if err = userTpl.Execute(userBuf, &userData); err != nil {
+		t.Fatal(err)
+	}
+
+	config := fmt.Sprintf("%s\n%s", svcBuf.String(), userBuf.String())
+
+	fullUserResourceName := "exoscale_dbaas_clickhouse_user.test_user"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		CheckDestroy:             CheckServiceDestroy("clickhouse", svcData.Name),
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fullUserResourceName, "username", userData.Username),
+					resource.TestCheckResourceAttr(fullUserResourceName, "zone", testutils.TestZoneName),
+					resource.TestCheckResourceAttrSet(fullUserResourceName, "password"),
+					resource.TestCheckNoResourceAttr(fullUserResourceName, "type"),
+					resource.TestCheckResourceAttrSet(fullUserResourceName, "id"),
+				),
+			},
+			{
+				// Import
+				ResourceName: fullUserResourceName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s@%s", svcData.Name, userData.Username, testutils.TestZoneName), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+package iam
+
+import (
+	"context"
+	"sort"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+const DataSourceUsersDescription = `List Exoscale [IAM](https://community.exoscale.com/documentation/iam/) organization users.
+
+Corresponding resource: [exoscale_iam_user](../resources/iam_user.md).`
+
+var _ datasource.DataSourceWithConfigure = &DataSourceUsers{}
+
+func NewDataSourceUsers() datasource.DataSource {
+	return &DataSourceUsers{}
+}
+
+type DataSourceUsers struct {
+	client *v3.Client
+}
+
+type DataSourceUsersModel struct {
+	RoleID types.String `tfsdk:"role_id"`
+	Users  []UserModel  `tfsdk:"users"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type UserModel struct {
+	ID     types.String `tfsdk:"id"`
+	Email  types.String `tfsdk:"email"`
+	RoleID types.String `tfsdk:"role_id"`
+
+	Pending                 types.Bool `tfsdk:"pending"`
+	SSO                     types.Bool `tfsdk:"sso"`
+	TwoFactorAuthentication types.Bool `tfsdk:"two_factor_authentication"`
+}
+
+func (d *DataSourceUsers) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_iam_users"
+}
+
+func (d *DataSourceUsers) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceUsersDescription,
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the users bound to this [exoscale_iam_role](../resources/iam_role.md) ID.",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The organization users (sorted by email address).",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The user ID.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The user email address.",
+							Computed:            true,
+						},
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "The IAM Role ID bound to the user.",
+							Computed:            true,
+						},
+						"pending": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user has not accepted the invitation yet (i.e. has no Exoscale account).",
+							Computed:            true,
+						},
+						"sso": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user signs in with SSO.",
+							Computed:            true,
+						},
+						"two_factor_authentication": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user has enabled two-factor authentication.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourceUsers) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (d *DataSourceUsers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceUsersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list IAM Users",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Users = make([]UserModel, 0, len(users.Users))
+	for _, user := range users.Users {
+		model := UserModel{
+			ID:                      types.StringValue(user.ID.String()),
+			Email:                   types.StringValue(user.Email),
+			RoleID:                  types.StringNull(),
+			Pending:                 types.BoolPointerValue(user.Pending),
+			SSO:                     types.BoolPointerValue(user.Sso),
+			TwoFactorAuthentication: types.BoolPointerValue(user.TwoFactorAuthentication),
+		}
+		if user.Role != nil {
+			model.RoleID = types.StringValue(user.Role.ID.String())
+		}
+
+		if !data.RoleID.IsNull() && !model.RoleID.Equal(data.RoleID) {
+			continue
+		}
+
+		data.Users = append(data.Users, model)
+	}
+
+	sort.Slice(data.Users, func(i, j int) bool {
+		return data.Users[i].Email.ValueString() < data.Users[j].Email.ValueString()
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,48 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testDataSourceUsers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		roleName         string = acctest.RandomWithPrefix(testutils.Prefix + "-role")
+		email            string = acctest.RandomWithPrefix(testutils.Prefix+"-user") + "@example.com"
+		fullResourceName string = "data.exoscale_iam_users.test"
+	)
+
+	config := testIAMRoleConfig(t, "test", roleName) + fmt.Sprintf(`
+resource "exoscale_iam_user" "test" {
+  email   = %q
+  role_id = exoscale_iam_role.test.id
+}
+
+data "exoscale_iam_users" "test" {
+  role_id = exoscale_iam_user.test.role_id
+}
+`, email)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(fullResourceName, "users.0.id", "exoscale_iam_user.test", "id"),
+					resource.TestCheckResourceAttr(fullResourceName, "users.0.email", email),
+					resource.TestCheckResourceAttr(fullResourceName, "users.0.pending", "true"),
+				),
+			},
+		},
+	})
+}
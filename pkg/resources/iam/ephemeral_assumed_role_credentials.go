@@ -0,0 +1,131 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+var _ ephemeral.EphemeralResource = &EphemeralAssumedRoleCredentials{}
+var _ ephemeral.EphemeralResourceWithConfigure = &EphemeralAssumedRoleCredentials{}
+
+// EphemeralAssumedRoleCredentials mints temporary IAM role credentials without persisting them in state.
+type EphemeralAssumedRoleCredentials struct {
+	client *v3.Client
+}
+
+type EphemeralAssumedRoleCredentialsModel struct {
+	RoleID    types.String   `tfsdk:"role_id"`
+	TTL       types.Int64    `tfsdk:"ttl"`
+	Key       types.String   `tfsdk:"key"`
+	Secret    types.String   `tfsdk:"secret"`
+	ExpiresAt types.String   `tfsdk:"expires_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewEphemeralAssumedRoleCredentials() ephemeral.EphemeralResource {
+	return &EphemeralAssumedRoleCredentials{}
+}
+
+func (r *EphemeralAssumedRoleCredentials) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_assumed_role_credentials"
+}
+
+func (r *EphemeralAssumedRoleCredentials) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generate temporary API credentials by assuming an Exoscale [IAM](https://community.exoscale.com/documentation/iam/) Role (e.g. to hand them over to a workload or another provider).\n\n" +
+			"The credentials are never persisted in the Terraform state or plan. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The [exoscale_iam_role](../resources/iam_role.md) ID to assume.",
+				Required:            true,
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The credentials lifetime in seconds, within the maximum allowed by the role (default: `%d`).", providerConfig.DefaultAssumeRoleTTL),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The temporary API key.",
+				Computed:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The temporary API secret.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The credentials expiration date.",
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (r *EphemeralAssumedRoleCredentials) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *EphemeralAssumedRoleCredentials) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralAssumedRoleCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Open(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	roleID, err := v3.ParseUUID(data.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_id"), "parse ID", fmt.Sprintf("error parsing role ID: %s", err))
+		return
+	}
+
+	ttl := int64(providerConfig.DefaultAssumeRoleTTL)
+	if !data.TTL.IsNull() {
+		ttl = data.TTL.ValueInt64()
+	}
+
+	creds, err := r.client.AssumeIAMRole(ctx, roleID, v3.AssumeIAMRoleRequest{Ttl: ttl})
+	if err != nil {
+		resp.Diagnostics.AddError("assume", fmt.Sprintf("unable to assume IAM role: %s", err))
+		return
+	}
+
+	data.Key = types.StringValue(creds.Key)
+	data.Secret = types.StringValue(creds.Secret)
+	data.ExpiresAt = types.StringValue(creds.ExpiresAT)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	tflog.Debug(ctx, "IAM role assumed", map[string]any{
+		"role_id": roleID.String(),
+		"key":     creds.Key,
+	})
+}
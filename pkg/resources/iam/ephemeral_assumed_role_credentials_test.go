@@ -0,0 +1,78 @@
+package iam_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+const envAssumableRoleID = "EXOSCALE_TEST_ASSUMABLE_ROLE_ID"
+
+func testEphemeralAssumedRoleCredentials(t *testing.T) {
+	t.Parallel()
+
+	roleID := os.Getenv(envAssumableRoleID)
+	if roleID == "" {
+		t.Skipf("env %s not set; skipping assumed role credentials acceptance test "+
+			"(set it to the ID of a pre-existing IAM role that the test API key may assume)",
+			envAssumableRoleID)
+	}
+
+	config := fmt.Sprintf(`
+ephemeral "exoscale_iam_assumed_role_credentials" "test" {
+  role_id = %q
+  ttl     = 300
+}
+
+provider "echo" {
+  data = ephemeral.exoscale_iam_assumed_role_credentials.test
+}
+
+resource "echo" "test" {}
+`,
+		roleID,
+	)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("role_id"),
+						knownvalue.StringExact(roleID),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("key"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("secret"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("expires_at"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
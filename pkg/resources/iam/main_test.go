@@ -11,4 +11,7 @@ func TestIAM(t *testing.T) {
 	t.Run("DataSourceRole", testDataSourceRole)
 	t.Run("DataSourceAPIKey", testDataSourceAPIKey)
 	t.Run("ResourceAPIKey", testResourceAPIKey)
+	t.Run("ResourceUser", testResourceUser)
+	t.Run("DataSourceUsers", testDataSourceUsers)
+	t.Run("EphemeralAssumedRoleCredentials", testEphemeralAssumedRoleCredentials)
 }
@@ -0,0 +1,444 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+)
+
+const ResourceUserDescription = `Manage Exoscale [IAM](https://community.exoscale.com/documentation/iam/) organization users.
+
+Creating this resource invites the user to join the organization, with the given IAM Role.
+
+Corresponding data source: [exoscale_iam_users](../data-sources/iam_users.md).`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceUser{}
+var _ resource.ResourceWithImportState = &ResourceUser{}
+
+func NewResourceUser() resource.Resource {
+	return &ResourceUser{}
+}
+
+// ResourceUser defines the IAM organization user resource implementation.
+type ResourceUser struct {
+	client *v3.Client
+}
+
+// ResourceUserModel describes the IAM organization user resource data model.
+type ResourceUserModel struct {
+	ID     types.String `tfsdk:"id"`
+	Email  types.String `tfsdk:"email"`
+	RoleID types.String `tfsdk:"role_id"`
+
+	Pending                 types.Bool `tfsdk:"pending"`
+	SSO                     types.Bool `tfsdk:"sso"`
+	TwoFactorAuthentication types.Bool `tfsdk:"two_factor_authentication"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ResourceUser) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_user"
+}
+
+func (r *ResourceUser) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceUserDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "❗ The user email address.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The [exoscale_iam_role](./iam_role.md) ID bound to the user.",
+				Required:            true,
+			},
+			"pending": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has not accepted the invitation yet (i.e. has no Exoscale account).",
+				Computed:            true,
+			},
+			"sso": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user signs in with SSO.",
+				Computed:            true,
+			},
+			"two_factor_authentication": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has enabled two-factor authentication.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ResourceUser) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *ResourceUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ResourceUserModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	roleID, err := v3.ParseUUID(data.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_id"), "Invalid IAM Role ID", err.Error())
+		return
+	}
+
+	op, err := r.client.CreateUser(ctx, v3.CreateUserRequest{
+		Email: data.Email.ValueString(),
+		Role:  &v3.IAMRole{ID: roleID},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create IAM User",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err = r.client.Wait(ctx, op, v3.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create IAM User",
+			err.Error(),
+		)
+		return
+	}
+
+	if op.Reference != nil {
+		data.ID = types.StringValue(op.Reference.ID.String())
+	} else {
+		// Fall back to the email address, which is unique within the organization.
+		users, err := r.client.ListUsers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to list IAM Users",
+				err.Error(),
+			)
+			return
+		}
+
+		user, err := findUserByEmail(users, data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to find created IAM User",
+				err.Error(),
+			)
+			return
+		}
+		data.ID = types.StringValue(user.ID.String())
+	}
+
+	// Save the user right away, so that it is still tracked if reading it fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), data.Email)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), data.RoleID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read created user
+	if r.read(ctx, &resp.Diagnostics, &data) {
+		resp.Diagnostics.AddError(
+			"Unable to read created IAM User",
+			fmt.Sprintf("IAM User %s not found", data.ID.ValueString()),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource created", map[string]any{
+		"id": data.ID,
+	})
+}
+
+func (r *ResourceUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ResourceUserModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	clearState := r.read(ctx, &resp.Diagnostics, &data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if clearState {
+		// Delete resource because it does not exist
+		resp.State.RemoveResource(ctx)
+	} else {
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+
+	tflog.Trace(ctx, "resource read done", map[string]any{
+		"id": data.ID,
+	})
+}
+
+func (r *ResourceUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var stateData, planData ResourceUserModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := planData.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	if !planData.RoleID.Equal(stateData.RoleID) {
+		userID, err := v3.ParseUUID(stateData.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid IAM User ID", err.Error())
+			return
+		}
+
+		roleID, err := v3.ParseUUID(planData.RoleID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("role_id"), "Invalid IAM Role ID", err.Error())
+			return
+		}
+
+		op, err := r.client.UpdateUserRole(ctx, userID, v3.UpdateUserRoleRequest{
+			Role: &v3.IAMRole{ID: roleID},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to update IAM User role",
+				err.Error(),
+			)
+			return
+		}
+
+		if _, err := r.client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to update IAM User role",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	planData.ID = stateData.ID
+
+	// Read updated user
+	if r.read(ctx, &resp.Diagnostics, &planData) {
+		resp.Diagnostics.AddError(
+			"Unable to read updated IAM User",
+			fmt.Sprintf("IAM User %s not found", planData.ID.ValueString()),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+
+	tflog.Trace(ctx, "resource updated", map[string]any{
+		"id": planData.ID,
+	})
+}
+
+func (r *ResourceUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ResourceUserModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout
+	t, diags := data.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+
+	userID, err := v3.ParseUUID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid IAM User ID", err.Error())
+		return
+	}
+
+	op, err := r.client.DeleteUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to delete IAM User",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := r.client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete IAM User",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]any{
+		"id": data.ID,
+	})
+}
+
+func (r *ResourceUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data ResourceUserModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Timeouts = timeouts
+
+	data.ID = types.StringValue(req.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]any{
+		"id": data.ID,
+	})
+}
+
+func (r *ResourceUser) read(
+	ctx context.Context,
+	d *diag.Diagnostics,
+	data *ResourceUserModel,
+) (clearState bool) {
+	// There is no endpoint to get a single user.
+	users, err := r.client.ListUsers(ctx)
+	if err != nil {
+		d.AddError(
+			"Unable to list IAM Users",
+			err.Error(),
+		)
+		return false
+	}
+
+	user, err := users.FindUser(data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return true
+		}
+		d.AddError(
+			"Unable to get IAM User",
+			err.Error(),
+		)
+		return false
+	}
+
+	// Email addresses are case-insensitive: keep the configured spelling.
+	if !strings.EqualFold(data.Email.ValueString(), user.Email) {
+		data.Email = types.StringValue(user.Email)
+	}
+	data.RoleID = types.StringNull()
+	if user.Role != nil {
+		data.RoleID = types.StringValue(user.Role.ID.String())
+	}
+	data.Pending = types.BoolPointerValue(user.Pending)
+	data.SSO = types.BoolPointerValue(user.Sso)
+	data.TwoFactorAuthentication = types.BoolPointerValue(user.TwoFactorAuthentication)
+
+	return false
+}
+
+// findUserByEmail returns the user with the given email address.
+func findUserByEmail(users *v3.ListUsersResponse, email string) (v3.User, error) {
+	for _, user := range users.Users {
+		if strings.EqualFold(user.Email, email) {
+			return user, nil
+		}
+	}
+
+	return v3.User{}, fmt.Errorf("user %q: %w", email, v3.ErrNotFound)
+}
@@ -0,0 +1,111 @@
+package iam_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"text/template"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testIAMRoleConfig renders a minimal exoscale_iam_role resource.
+func testIAMRoleConfig(t *testing.T, resourceName, name string) string {
+	tpl, err := template.ParseFiles("../../testutils/testdata/resource_iam_role.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := testutils.ResourceIAMRole{
+		ResourceName: resourceName,
+		Name:         name,
+		Editable:     true,
+
+		Policy: &testutils.ResourceIAMOrgPolicyModel{
+			DefaultServiceStrategy: "allow",
+			Services: map[string]testutils.ResourceIAMPolicyServicesModel{
+				"sos": {Type: "allow"},
+			},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	if err := tpl.Execute(buf, &data); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.String() + "\n"
+}
+
+func testResourceUser(t *testing.T) {
+	t.Parallel()
+
+	var (
+		roleName1        string = acctest.RandomWithPrefix(testutils.Prefix + "-role")
+		roleName2        string = acctest.RandomWithPrefix(testutils.Prefix + "-role")
+		email            string = acctest.RandomWithPrefix(testutils.Prefix+"-user") + "@example.com"
+		fullResourceName string = "exoscale_iam_user.test"
+	)
+
+	roles := testIAMRoleConfig(t, "test1", roleName1) + testIAMRoleConfig(t, "test2", roleName2)
+
+	configCreate := roles + fmt.Sprintf(`
+resource "exoscale_iam_user" "test" {
+  email   = %q
+  role_id = exoscale_iam_role.test1.id
+}
+`, email)
+
+	configUpdate := roles + fmt.Sprintf(`
+resource "exoscale_iam_user" "test" {
+  email   = %q
+  role_id = exoscale_iam_role.test2.id
+}
+`, email)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: configCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fullResourceName, "id"),
+					resource.TestCheckResourceAttr(fullResourceName, "email", email),
+					resource.TestCheckResourceAttrPair(fullResourceName, "role_id", "exoscale_iam_role.test1", "id"),
+					resource.TestCheckResourceAttr(fullResourceName, "pending", "true"),
+				),
+			},
+			// Update role in place
+			{
+				Config: configUpdate,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fullResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "email", email),
+					resource.TestCheckResourceAttrPair(fullResourceName, "role_id", "exoscale_iam_role.test2", "id"),
+				),
+			},
+			{
+				// Import
+				ResourceName: fullResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						return s.RootModule().Resources[fullResourceName].Primary.ID, nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+var _ ephemeral.EphemeralResource = &EphemeralPassword{}
+var _ ephemeral.EphemeralResourceWithConfigure = &EphemeralPassword{}
+
+// EphemeralPassword reveals the password of a compute instance without persisting it in state.
+type EphemeralPassword struct {
+	client *v3.Client
+}
+
+type EphemeralPasswordModel struct {
+	InstanceID types.String   `tfsdk:"instance_id"`
+	Zone       types.String   `tfsdk:"zone"`
+	Reset      types.Bool     `tfsdk:"reset"`
+	Password   types.String   `tfsdk:"password"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewEphemeralPassword() ephemeral.EphemeralResource {
+	return &EphemeralPassword{}
+}
+
+func (r *EphemeralPassword) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_instance_password"
+}
+
+func (r *EphemeralPassword) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reveal the password of an Exoscale [Compute Instance](https://community.exoscale.com/documentation/compute/) (e.g. the initial `Administrator` password of a Windows instance).\n\n" +
+			"The password is never persisted in the Terraform state or plan. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				MarkdownDescription: "The [exoscale_compute_instance](../resources/compute_instance.md) ID.",
+				Required:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"reset": schema.BoolAttribute{
+				MarkdownDescription: "Reset the instance password before revealing it (boolean; default: `false`). **WARNING**: the password is reset every time the ephemeral resource is opened, i.e. on each plan and apply.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The instance password.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (r *EphemeralPassword) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *EphemeralPassword) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralPasswordModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Open(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, v3.ZoneName(data.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	instanceID, err := v3.ParseUUID(data.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parse ID", fmt.Sprintf("error parsing instance ID: %s", err))
+		return
+	}
+
+	if data.Reset.ValueBool() {
+		op, err := client.ResetInstancePassword(ctx, instanceID)
+		if err != nil {
+			resp.Diagnostics.AddError("reset", fmt.Sprintf("unable to reset instance password: %s", err))
+			return
+		}
+
+		if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+			resp.Diagnostics.AddError("reset", fmt.Sprintf("unable to reset instance password: %s", err))
+			return
+		}
+
+		tflog.Debug(ctx, "instance password reset", map[string]any{"instance_id": instanceID.String()})
+	}
+
+	password, err := client.RevealInstancePassword(ctx, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError("reveal", fmt.Sprintf("unable to reveal instance password: %s", err))
+		return
+	}
+
+	data.Password = types.StringValue(password.Password)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
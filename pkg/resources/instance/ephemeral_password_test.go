@@ -0,0 +1,69 @@
+package instance_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+const envWindowsInstanceID = "EXOSCALE_TEST_WINDOWS_INSTANCE_ID"
+
+func testEphemeralPassword(t *testing.T) {
+	t.Parallel()
+
+	instanceID := os.Getenv(envWindowsInstanceID)
+	if instanceID == "" {
+		t.Skipf("env %s not set; skipping instance password acceptance test "+
+			"(set it to the ID of a pre-existing Windows instance in zone %s)",
+			envWindowsInstanceID, testutils.TestZoneName)
+	}
+
+	config := fmt.Sprintf(`
+ephemeral "exoscale_compute_instance_password" "test" {
+  zone        = %q
+  instance_id = %q
+}
+
+provider "echo" {
+  data = ephemeral.exoscale_compute_instance_password.test
+}
+
+resource "echo" "test" {}
+`,
+		testutils.TestZoneName,
+		instanceID,
+	)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("instance_id"),
+						knownvalue.StringExact(instanceID),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("password"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
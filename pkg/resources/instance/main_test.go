@@ -8,6 +8,8 @@ func TestInstance(t *testing.T) {
 	t.Run("DataSource", testDataSource)
 	t.Run("DataSourceList", testListDataSource)
 	t.Run("Resource", testResource)
+	t.Run("Resource/InvalidNetworkInterface", testResourceInvalidNetworkInterface)
 	t.Run("DestroyProtection/ExplicitValue", testExplicitDestroyProtection)
 	t.Run("DestroyProtection/DefaultValue", testDefaultDestroyProtection)
+	t.Run("EphemeralPassword", testEphemeralPassword)
 }
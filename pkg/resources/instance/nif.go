@@ -3,12 +3,22 @@ package instance
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// unknownConfigValue stands for a configured value not known until apply.
+const unknownConfigValue = "(known after apply)"
+
 type NetworkInterface struct {
 	NetworkID  string  `json:"network_id"`
+	SubnetID   string  `json:"subnet_id"`
+	VPCID      string  `json:"vpc_id"`
 	IPAddress  *string `json:"ip_address"`
 	MacAddress string  `json:"mac_address"`
 }
@@ -41,3 +51,124 @@ func (n NetworkInterface) ToInterface() (map[string]any, error) {
 
 	return nif, nil
 }
+
+// IsSubnet reports whether the network interface is attached to a VPC subnet
+// rather than to a private network.
+func (n NetworkInterface) IsSubnet() bool {
+	return n.SubnetID != ""
+}
+
+// Validate checks that the network interface targets exactly one network.
+func (n NetworkInterface) Validate() error {
+	switch {
+	case n.NetworkID != "" && n.SubnetID != "":
+		return errors.New("network_interface: only one of network_id or subnet_id can be specified")
+	case n.NetworkID == "" && n.SubnetID == "":
+		return errors.New("network_interface: one of network_id or subnet_id must be specified")
+	case n.IsSubnet() && n.VPCID == "":
+		return errors.New("network_interface: vpc_id must be specified along with subnet_id")
+	case n.IsSubnet() && n.IPAddress != nil && *n.IPAddress != "":
+		// The subnet attachment API doesn't accept an address yet.
+		return errors.New("network_interface: ip_address is not supported for VPC subnet interfaces")
+	}
+
+	return nil
+}
+
+// networkInterfaceFromConfig builds a network interface from its raw configuration,
+// unknown values being replaced by a placeholder so Validate can be run at plan time.
+func networkInterfaceFromConfig(v cty.Value) NetworkInterface {
+	attr := func(name string) string {
+		a := v.GetAttr(name)
+		switch {
+		case a.IsNull():
+			return ""
+		case !a.IsKnown():
+			return unknownConfigValue
+		}
+		return a.AsString()
+	}
+
+	nif := NetworkInterface{
+		NetworkID: attr("network_id"),
+		SubnetID:  attr("subnet_id"),
+		VPCID:     attr("vpc_id"),
+	}
+
+	if ip := v.GetAttr("ip_address"); ip.IsKnown() && !ip.IsNull() {
+		address := ip.AsString()
+		nif.IPAddress = &address
+	}
+
+	return nif
+}
+
+// validateNetworkInterfacesDiff validates the configured network interfaces at plan time,
+// before any instance gets created.
+func validateNetworkInterfacesDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	nifs := rawConfig.GetAttr(AttrNetworkInterface)
+	if nifs.IsNull() || !nifs.IsKnown() {
+		return nil
+	}
+
+	for it := nifs.ElementIterator(); it.Next(); {
+		_, v := it.Element()
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		if err := networkInterfaceFromConfig(v).Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AttachSubnet attaches the instance to the VPC subnet of the network interface.
+func (n NetworkInterface) AttachSubnet(ctx context.Context, client *v3.Client, instanceID v3.UUID) error {
+	op, err := client.AttachInstanceToSubnet(
+		ctx,
+		v3.UUID(n.VPCID),
+		v3.UUID(n.SubnetID),
+		v3.AttachInstanceToSubnetRequest{Instance: &v3.InstanceRef{ID: instanceID}},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to attach VPC subnet %s: %w", n.SubnetID, err)
+	}
+	if _, err = client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+		return fmt.Errorf("unable to attach VPC subnet %s: %w", n.SubnetID, err)
+	}
+
+	return nil
+}
+
+// DetachSubnet detaches the instance from the VPC subnet of the network interface.
+// Already detached subnets are ignored.
+func (n NetworkInterface) DetachSubnet(ctx context.Context, client *v3.Client, instanceID v3.UUID) error {
+	op, err := client.DetachInstanceFromSubnet(
+		ctx,
+		v3.UUID(n.VPCID),
+		v3.UUID(n.SubnetID),
+		v3.DetachInstanceFromSubnetRequest{Instance: &v3.InstanceRef{ID: instanceID}},
+	)
+	if err == nil {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	}
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			tflog.Debug(ctx, "VPC subnet already detached, ignoring", map[string]any{
+				"id": n.SubnetID,
+			})
+			return nil
+		}
+		return fmt.Errorf("unable to detach VPC subnet %s: %w", n.SubnetID, err)
+	}
+
+	return nil
+}
@@ -105,22 +105,32 @@ func Resource() *schema.Resource {
 			Deprecated:  "Use the network_interface block instead.",
 		},
 		AttrNetworkInterface: {
-			Description: "Private network interfaces (may be specified multiple times). Structure is documented below.",
+			Description: "Private network or VPC subnet interfaces (may be specified multiple times). Structure is documented below.",
 			Type:        schema.TypeSet,
 			Optional:    true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"ip_address": {
-						Description:      "The IPv4 address to request as static DHCP lease if the network interface is attached to a *managed* private network.",
+						Description:      "The IPv4 address to request as static DHCP lease if the network interface is attached to a *managed* private network (not supported for VPC subnets).",
 						Type:             schema.TypeString,
 						Optional:         true,
 						Computed:         true,
 						ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
 					},
 					"network_id": {
-						Description: "The [exoscale_private_network](./private_network.md) (ID) to attach to the instance.",
+						Description: "The [exoscale_private_network](./private_network.md) (ID) to attach to the instance (conflicts with `subnet_id`).",
 						Type:        schema.TypeString,
-						Required:    true,
+						Optional:    true,
+					},
+					"subnet_id": {
+						Description: "The [exoscale_vpc_subnet](./vpc_subnet.md) (ID) to attach to the instance (conflicts with `network_id`). Subnet attachments are not reported back by the API, so drift cannot be detected.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"vpc_id": {
+						Description: "The [exoscale_vpc](./vpc.md) (ID) the subnet belongs to (required with `subnet_id`).",
+						Type:        schema.TypeString,
+						Optional:    true,
 					},
 					"mac_address": {
 						Description: "MAC address",
@@ -225,6 +235,8 @@ func Resource() *schema.Resource {
 		UpdateContext: rUpdate,
 		DeleteContext: rDelete,
 
+		CustomizeDiff: validateNetworkInterfacesDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: utils.ZonedStateContextFunc,
 		},
@@ -350,6 +362,23 @@ func rCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnos
 		instanceRequest.UserData = userData
 	}
 
+	// Validate the network interfaces before creating the instance, not to leave it tainted.
+	var nifs []*NetworkInterface
+	if nifSet, ok := d.Get(AttrNetworkInterface).(*schema.Set); ok {
+		for _, raw := range nifSet.List() {
+			nif, err := NewNetworkInterface(raw)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err := nif.Validate(); err != nil {
+				return diag.FromErr(err)
+			}
+
+			nifs = append(nifs, nif)
+		}
+	}
+
 	op, err := clientV3.CreateInstance(ctx, *instanceRequest)
 	if err != nil {
 		return diag.FromErr(err)
@@ -391,29 +420,29 @@ func rCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnos
 		}
 	}
 
-	if nifSet, ok := d.Get(AttrNetworkInterface).(*schema.Set); ok {
-		for _, nif := range nifSet.List() {
-			nif, err := NewNetworkInterface(nif)
-			if err != nil {
+	for _, nif := range nifs {
+		if nif.IsSubnet() {
+			if err := nif.AttachSubnet(ctx, clientV3, instanceId); err != nil {
 				return diag.FromErr(err)
 			}
+			continue
+		}
 
-			op, err := clientV3.AttachInstanceToPrivateNetwork(
-				ctx,
-				v3.UUID(nif.NetworkID),
-				v3.AttachInstanceToPrivateNetworkRequest{
-					Instance: &v3.AttachInstanceToPrivateNetworkRequestInstance{
-						ID: instanceId,
-					},
-					IP: net.ParseIP(*nif.IPAddress),
+		op, err := clientV3.AttachInstanceToPrivateNetwork(
+			ctx,
+			v3.UUID(nif.NetworkID),
+			v3.AttachInstanceToPrivateNetworkRequest{
+				Instance: &v3.AttachInstanceToPrivateNetworkRequestInstance{
+					ID: instanceId,
 				},
-			)
-			if err != nil {
-				return diag.Errorf("unable to attach Private Network %s: %s", nif.NetworkID, err)
-			}
-			if _, err = clientV3.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
-				return diag.Errorf("unable to attach Private Network %s: %s", nif.NetworkID, err)
-			}
+				IP: net.ParseIP(*nif.IPAddress),
+			},
+		)
+		if err != nil {
+			return diag.Errorf("unable to attach Private Network %s: %s", nif.NetworkID, err)
+		}
+		if _, err = clientV3.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+			return diag.Errorf("unable to attach Private Network %s: %s", nif.NetworkID, err)
 		}
 	}
 
@@ -757,6 +786,13 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnos
 					return diag.FromErr(err)
 				}
 
+				if nif.IsSubnet() {
+					if err := nif.DetachSubnet(ctx, client, instance.ID); err != nil {
+						return diag.FromErr(err)
+					}
+					continue
+				}
+
 				op, err := client.DetachInstanceFromPrivateNetwork(
 					ctx,
 					v3.UUID(nif.NetworkID),
@@ -791,6 +827,17 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnos
 					return diag.FromErr(err)
 				}
 
+				if err := nif.Validate(); err != nil {
+					return diag.FromErr(err)
+				}
+
+				if nif.IsSubnet() {
+					if err := nif.AttachSubnet(ctx, client, instance.ID); err != nil {
+						return diag.FromErr(err)
+					}
+					continue
+				}
+
 				op, err := client.AttachInstanceToPrivateNetwork(
 					ctx,
 					v3.UUID(nif.NetworkID),
@@ -1113,6 +1160,19 @@ func rApply( //nolint:gocyclo
 		privateNetworkIDs := make([]string, len(instance.PrivateNetworks))
 		networkInterfaces := make([]map[string]any, len(instance.PrivateNetworks))
 
+		var subnetInterfaces []map[string]any
+		if nifSet, ok := d.Get(AttrNetworkInterface).(*schema.Set); ok {
+			for _, raw := range nifSet.List() {
+				nif, err := NewNetworkInterface(raw)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				if nif.IsSubnet() {
+					subnetInterfaces = append(subnetInterfaces, raw.(map[string]any))
+				}
+			}
+		}
+
 		for i, privnet := range instance.PrivateNetworks {
 			privateNetwork, err := clientV3.GetPrivateNetwork(ctx, privnet.ID)
 			if err != nil {
@@ -1128,7 +1188,11 @@ func rApply( //nolint:gocyclo
 				}
 			}
 
-			nif, err := NetworkInterface{privnet.ID.String(), instanceAddress, privnet.MACAddress}.ToInterface()
+			nif, err := NetworkInterface{
+				NetworkID:  privnet.ID.String(),
+				IPAddress:  instanceAddress,
+				MacAddress: privnet.MACAddress,
+			}.ToInterface()
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -1136,6 +1200,10 @@ func rApply( //nolint:gocyclo
 			networkInterfaces[i] = nif
 			privateNetworkIDs[i] = privnet.ID.String()
 		}
+
+		// VPC subnet attachments are not part of the instance details returned
+		// by the API: keep the ones already known.
+		networkInterfaces = append(networkInterfaces, subnetInterfaces...)
 		if err := d.Set(AttrPrivateNetworkIDs, privateNetworkIDs); err != nil {
 			return diag.FromErr(err)
 		}
@@ -2,6 +2,7 @@ package instance_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -891,3 +892,34 @@ func testResource(t *testing.T) {
 		},
 	})
 }
+
+func testResourceInvalidNetworkInterface(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "exoscale_compute_instance" "test" {
+  zone        = "%s"
+  name        = "%s"
+  type        = "%s"
+  disk_size   = %d
+  template_id = "8ee7b5cb-0e7f-4dc8-8d3d-92b8d2d4b7c1"
+
+  network_interface {}
+}
+`,
+					testutils.TestZoneName,
+					rName,
+					rType,
+					rDiskSize,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("one of network_id or subnet_id must be specified"),
+			},
+		},
+	})
+}
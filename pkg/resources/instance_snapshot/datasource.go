@@ -0,0 +1,316 @@
+package instance_snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const DataSourceSnapshotDescription = `Fetch [Exoscale Compute Instance](https://community.exoscale.com/documentation/compute/) Snapshot.
+
+The snapshot can be looked up by ID, or by instance ID and/or creation date: when several snapshots match, the most recent one is returned.
+
+Corresponding resource: [exoscale_compute_instance_snapshot](../resources/compute_instance_snapshot.md).`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &DataSourceSnapshot{}
+
+// DataSourceSnapshot defines the data source implementation.
+type DataSourceSnapshot struct {
+	client *exoscale.Client
+}
+
+// NewDataSourceSnapshot creates instance of DataSourceSnapshot.
+func NewDataSourceSnapshot() datasource.DataSource {
+	return &DataSourceSnapshot{}
+}
+
+// DataSourceSnapshotModel defines the data source data model.
+type DataSourceSnapshotModel struct {
+	ID                    types.String `tfsdk:"id"`
+	InstanceID            types.String `tfsdk:"instance_id"`
+	CreatedAfter          types.String `tfsdk:"created_after"`
+	CreatedBefore         types.String `tfsdk:"created_before"`
+	Name                  types.String `tfsdk:"name"`
+	Size                  types.Int64  `tfsdk:"size"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	State                 types.String `tfsdk:"state"`
+	ApplicationConsistent types.Bool   `tfsdk:"application_consistent"`
+	Zone                  types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies data source name.
+func (d *DataSourceSnapshot) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_compute_instance_snapshot"
+}
+
+// Schema defines data source attributes.
+func (d *DataSourceSnapshot) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceSnapshotDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Snapshot ID to match.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"instance_id": schema.StringAttribute{
+				MarkdownDescription: "Compute instance ID to match.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only match snapshots created after this date ([RFC 3339](https://www.rfc-editor.org/rfc/rfc3339) format, e.g. `2024-01-02T15:04:05Z`).",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only match snapshots created before this date ([RFC 3339](https://www.rfc-editor.org/rfc/rfc3339) format, e.g. `2024-01-02T15:04:05Z`).",
+				Optional:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Snapshot name.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Snapshot size in GiB.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Snapshot creation date.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Snapshot state.",
+				Computed:            true,
+			},
+			"application_consistent": schema.BoolAttribute{
+				MarkdownDescription: "Whether the snapshot was taken using an application-consistent method.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up datasource dependencies.
+func (d *DataSourceSnapshot) Configure(
+	ctx context.Context,
+	r datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if r.ProviderData == nil {
+		return
+	}
+
+	d.client = r.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Read defines how the data source updates Terraform's state to reflect the retrieved data.
+func (d *DataSourceSnapshot) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan DataSourceSnapshotModel
+
+	// Load Terraform plan into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		d.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	filter, err := newSnapshotFilter(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"invalid snapshot filter",
+			err.Error(),
+		)
+		return
+	}
+
+	var candidates []exoscale.Snapshot
+	if !plan.ID.IsNull() && !plan.ID.IsUnknown() {
+		id, err := exoscale.ParseUUID(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to parse snapshot ID",
+				err.Error(),
+			)
+			return
+		}
+
+		snapshot, err := client.GetSnapshot(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to get instance snapshot",
+				err.Error(),
+			)
+			return
+		}
+		candidates = append(candidates, *snapshot)
+	} else {
+		snapshots, err := client.ListSnapshots(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to list instance snapshots",
+				err.Error(),
+			)
+			return
+		}
+		candidates = snapshots.Snapshots
+	}
+
+	snapshot, err := filter.mostRecent(candidates)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to find instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update state model.
+	plan.ID = types.StringValue(snapshot.ID.String())
+	plan.InstanceID = types.StringNull()
+	if snapshot.Instance != nil {
+		plan.InstanceID = types.StringValue(snapshot.Instance.ID.String())
+	}
+	plan.Name = types.StringValue(snapshot.Name)
+	plan.Size = types.Int64Value(snapshot.Size)
+	plan.CreatedAt = types.StringValue(snapshot.CreatedAT.String())
+	plan.State = types.StringValue(string(snapshot.State))
+	plan.ApplicationConsistent = types.BoolValue(utils.DefaultBool(snapshot.ApplicationConsistent, false))
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "datasource read done", map[string]any{
+		"id": plan.ID,
+	})
+}
+
+// snapshotFilter matches snapshots against the data source criteria.
+type snapshotFilter struct {
+	instanceID    string
+	createdAfter  time.Time
+	createdBefore time.Time
+}
+
+func newSnapshotFilter(data *DataSourceSnapshotModel) (*snapshotFilter, error) {
+	f := &snapshotFilter{}
+
+	if !data.InstanceID.IsNull() && !data.InstanceID.IsUnknown() {
+		f.instanceID = data.InstanceID.ValueString()
+	}
+
+	if !data.CreatedAfter.IsNull() {
+		t, err := time.Parse(time.RFC3339, data.CreatedAfter.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("created_after: %w", err)
+		}
+		f.createdAfter = t
+	}
+
+	if !data.CreatedBefore.IsNull() {
+		t, err := time.Parse(time.RFC3339, data.CreatedBefore.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("created_before: %w", err)
+		}
+		f.createdBefore = t
+	}
+
+	return f, nil
+}
+
+func (f *snapshotFilter) match(snapshot *exoscale.Snapshot) bool {
+	if f.instanceID != "" && (snapshot.Instance == nil || snapshot.Instance.ID.String() != f.instanceID) {
+		return false
+	}
+
+	if !f.createdAfter.IsZero() && !snapshot.CreatedAT.After(f.createdAfter) {
+		return false
+	}
+
+	if !f.createdBefore.IsZero() && !snapshot.CreatedAT.Before(f.createdBefore) {
+		return false
+	}
+
+	return true
+}
+
+// mostRecent returns the most recently created snapshot matching the filter.
+func (f *snapshotFilter) mostRecent(snapshots []exoscale.Snapshot) (*exoscale.Snapshot, error) {
+	var found *exoscale.Snapshot
+
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+		if !f.match(snapshot) {
+			continue
+		}
+
+		if found == nil || snapshot.CreatedAT.After(found.CreatedAT) {
+			found = snapshot
+		}
+	}
+
+	if found == nil {
+		return nil, errors.New("no snapshot matches the given criteria")
+	}
+
+	return found, nil
+}
@@ -0,0 +1,90 @@
+package instance_snapshot
+
+import (
+	"testing"
+	"time"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSnapshotFilterMostRecent(t *testing.T) {
+	t.Parallel()
+
+	instanceA := &exoscale.Instance{ID: exoscale.UUID("6e9e8c66-7e6a-4bd6-8a07-3f2ab22f36ee")}
+	instanceB := &exoscale.Instance{ID: exoscale.UUID("1f4d1c2a-5b9e-4f0e-9b2b-c6b6a1d0e3f4")}
+	day := func(d int) time.Time { return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC) }
+
+	snapshots := []exoscale.Snapshot{
+		{ID: "a1", Instance: instanceA, CreatedAT: day(1)},
+		{ID: "a2", Instance: instanceA, CreatedAT: day(10)},
+		{ID: "b1", Instance: instanceB, CreatedAT: day(5)},
+		{ID: "b2", Instance: instanceB, CreatedAT: day(20)},
+	}
+
+	tests := []struct {
+		name     string
+		data     DataSourceSnapshotModel
+		expected exoscale.UUID
+		wantErr  bool
+	}{
+		{
+			name:     "no criteria",
+			data:     DataSourceSnapshotModel{},
+			expected: "b2",
+		},
+		{
+			name:     "instance",
+			data:     DataSourceSnapshotModel{InstanceID: types.StringValue(instanceA.ID.String())},
+			expected: "a2",
+		},
+		{
+			name: "instance and created before",
+			data: DataSourceSnapshotModel{
+				InstanceID:    types.StringValue(instanceB.ID.String()),
+				CreatedBefore: types.StringValue("2024-01-15T00:00:00Z"),
+			},
+			expected: "b1",
+		},
+		{
+			name: "created window",
+			data: DataSourceSnapshotModel{
+				CreatedAfter:  types.StringValue("2024-01-02T00:00:00Z"),
+				CreatedBefore: types.StringValue("2024-01-08T00:00:00Z"),
+			},
+			expected: "b1",
+		},
+		{
+			name: "no match",
+			data: DataSourceSnapshotModel{
+				InstanceID:   types.StringValue(instanceA.ID.String()),
+				CreatedAfter: types.StringValue("2024-01-15T00:00:00Z"),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid date",
+			data:    DataSourceSnapshotModel{CreatedAfter: types.StringValue("yesterday")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := newSnapshotFilter(&tt.data)
+			if err == nil {
+				var snapshot *exoscale.Snapshot
+				snapshot, err = f.mostRecent(snapshots)
+				if err == nil && snapshot.ID != tt.expected {
+					t.Errorf("expected snapshot %q, got %q", tt.expected, snapshot.ID)
+				}
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error state: %v", err)
+			}
+		})
+	}
+}
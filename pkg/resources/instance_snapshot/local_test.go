@@ -0,0 +1,17 @@
+//go:build local_integration
+
+package instance_snapshot_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var flagAccount = flag.String("account", testutils.DefaultLocalAccount, "account name substring in exoscale.toml")
+
+func TestInstanceSnapshotLocal(t *testing.T) {
+	testutils.LoadLocalCreds(t, *flagAccount)
+	TestInstanceSnapshot(t)
+}
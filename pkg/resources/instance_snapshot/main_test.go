@@ -0,0 +1,126 @@
+package instance_snapshot_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	egoscale "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+func TestInstanceSnapshot(t *testing.T) {
+	t.Parallel()
+
+	instanceResourceName := "exoscale_compute_instance.test_instance"
+	snapshotResourceName := "exoscale_compute_instance_snapshot.test_snapshot"
+	snapshotByIDDataSourceName := "data.exoscale_compute_instance_snapshot.by_id"
+	snapshotByInstanceDataSourceName := "data.exoscale_compute_instance_snapshot.by_instance"
+	exportResourceName := "exoscale_compute_instance_snapshot_export.test_export"
+	var snapshotID string
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceSnapshotDestroy(testdataSpec.Zone, &snapshotID),
+		Steps: []resource.TestStep{
+			// 1 Create instance snapshot
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.snapshot_create.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(snapshotResourceName, "id", func(v string) error {
+						snapshotID = v
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair(snapshotResourceName, "instance_id", instanceResourceName, "id"),
+					resource.TestCheckResourceAttrSet(snapshotResourceName, "name"),
+					resource.TestCheckResourceAttrSet(snapshotResourceName, "size"),
+					resource.TestCheckResourceAttrSet(snapshotResourceName, "created_at"),
+					resource.TestCheckResourceAttr(snapshotResourceName, "state", "ready"),
+					resource.TestCheckResourceAttrPair(snapshotByIDDataSourceName, "instance_id", instanceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(snapshotByIDDataSourceName, "name", snapshotResourceName, "name"),
+					resource.TestCheckResourceAttr(snapshotByIDDataSourceName, "state", "ready"),
+					resource.TestCheckResourceAttrPair(snapshotByInstanceDataSourceName, "id", snapshotResourceName, "id"),
+					resource.TestCheckResourceAttrPair(snapshotByInstanceDataSourceName, "created_at", snapshotResourceName, "created_at"),
+				),
+			},
+			// 2 Export instance snapshot
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.snapshot_export.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(exportResourceName, "id", snapshotResourceName, "id"),
+					resource.TestCheckResourceAttrPair(exportResourceName, "snapshot_id", snapshotResourceName, "id"),
+					resource.TestCheckResourceAttrSet(exportResourceName, "url"),
+					resource.TestCheckResourceAttrSet(exportResourceName, "md5sum"),
+				),
+			},
+			// 3 Update timeouts only
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/003.snapshot_timeouts.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(snapshotResourceName, "id", func(v string) error {
+						if v != snapshotID {
+							return fmt.Errorf("expected snapshot %s to be kept, got %s", snapshotID, v)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet(snapshotResourceName, "size"),
+					resource.TestCheckResourceAttrSet(snapshotResourceName, "application_consistent"),
+					resource.TestCheckResourceAttr(snapshotResourceName, "state", "ready"),
+				),
+			},
+			// Import
+			{
+				ResourceName: snapshotResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", s.RootModule().Resources[snapshotResourceName].Primary.ID, testdataSpec.Zone), nil
+					}
+				}(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceSnapshotDestroy(zone string, snapshotID *string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if snapshotID == nil || *snapshotID == "" {
+			return errors.New("snapshot ID was not captured during the test")
+		}
+
+		defaultClient, err := testutils.APIClientV3()
+		if err != nil {
+			return fmt.Errorf("unable to initialize Exoscale client: %w", err)
+		}
+
+		ctx := context.Background()
+		client, err := utils.SwitchClientZone(ctx, defaultClient, egoscale.ZoneName(zone))
+		if err != nil {
+			return fmt.Errorf("unable to initialize Exoscale client: %w", err)
+		}
+
+		_, err = client.GetSnapshot(ctx, egoscale.UUID(*snapshotID))
+		if err != nil {
+			if errors.Is(err, egoscale.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		return errors.New("instance snapshot still exists")
+	}
+}
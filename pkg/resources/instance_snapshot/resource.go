@@ -0,0 +1,432 @@
+package instance_snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const ResourceSnapshotDescription = `Manage [Exoscale Compute Instance](https://community.exoscale.com/documentation/compute/) Snapshots.
+
+A snapshot captures the root disk of a Compute instance, it can later be used to revert the instance or promoted to a [template](./template.md).
+`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSnapshot{}
+var _ resource.ResourceWithImportState = &ResourceSnapshot{}
+
+// ResourceSnapshot defines the resource implementation.
+type ResourceSnapshot struct {
+	client *exoscale.Client
+}
+
+// NewResourceSnapshot creates instance of ResourceSnapshot.
+func NewResourceSnapshot() resource.Resource {
+	return &ResourceSnapshot{}
+}
+
+// ResourceSnapshotModel defines the resource data model.
+type ResourceSnapshotModel struct {
+	ID                    types.String `tfsdk:"id"`
+	InstanceID            types.String `tfsdk:"instance_id"`
+	Name                  types.String `tfsdk:"name"`
+	Size                  types.Int64  `tfsdk:"size"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	State                 types.String `tfsdk:"state"`
+	ApplicationConsistent types.Bool   `tfsdk:"application_consistent"`
+	Zone                  types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSnapshot) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_instance_snapshot"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceSnapshot) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceSnapshotDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				MarkdownDescription: "❗ The [exoscale_compute_instance](./compute_instance.md) ID to snapshot.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Snapshot name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Snapshot size in GiB.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Snapshot creation date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Snapshot state.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_consistent": schema.BoolAttribute{
+				MarkdownDescription: "Whether the snapshot was taken using an application-consistent method.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceSnapshot) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceSnapshot) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSnapshotModel
+
+	// Load Terraform plan into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	instanceID, err := exoscale.ParseUUID(plan.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse instance ID",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err := client.CreateSnapshot(ctx, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to create instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	if op.Reference == nil {
+		resp.Diagnostics.AddError(
+			"failed to create instance snapshot",
+			"operation did not reference the created snapshot",
+		)
+		return
+	}
+
+	// Update computed attributes before saving the state.
+	snapshot, err := client.GetSnapshot(ctx, op.Reference.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(snapshot.ID.String())
+	readSnapshotIntoModel(snapshot, &plan)
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]any{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceSnapshot) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSnapshotModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	// Read remote state.
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse snapshot ID",
+			err.Error(),
+		)
+		return
+	}
+
+	snapshot, err := client.GetSnapshot(ctx, id)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to get instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update state model.
+	readSnapshotIntoModel(snapshot, &state)
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]any{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceSnapshot) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ResourceSnapshotModel
+
+	// All attributes but timeouts force a replacement, nothing to update remotely.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceSnapshot) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSnapshotModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse snapshot ID",
+			err.Error(),
+		)
+		return
+	}
+
+	// Delete remote resource.
+	op, err := client.DeleteSnapshot(ctx, id)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to delete instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to delete instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]any{
+		"id": state.ID,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceSnapshot) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceSnapshotModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.ID = types.StringValue(idParts[0])
+	state.Zone = types.StringValue(idParts[1])
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]any{
+		"id": state.ID,
+	})
+}
+
+func readSnapshotIntoModel(snapshot *exoscale.Snapshot, data *ResourceSnapshotModel) {
+	data.Name = types.StringValue(snapshot.Name)
+	data.Size = types.Int64Value(snapshot.Size)
+	data.CreatedAt = types.StringValue(snapshot.CreatedAT.String())
+	data.State = types.StringValue(string(snapshot.State))
+	data.ApplicationConsistent = types.BoolValue(utils.DefaultBool(snapshot.ApplicationConsistent, false))
+
+	if snapshot.Instance != nil {
+		data.InstanceID = types.StringValue(snapshot.Instance.ID.String())
+	}
+}
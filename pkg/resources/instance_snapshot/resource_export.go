@@ -0,0 +1,358 @@
+package instance_snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const ResourceSnapshotExportDescription = `Export an [Exoscale Compute Instance](https://community.exoscale.com/documentation/compute/) Snapshot.
+
+The export produces a pre-signed URL which can be used to download the snapshot disk image (QCOW2 format), e.g. for off-site backups.
+
+!> **WARNING:** the download URL grants access to the snapshot data to anyone who knows it, and is stored in the Terraform state.
+
+~> **NOTE:** an export cannot be revoked: destroying this resource only removes it from the Terraform state.
+`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSnapshotExport{}
+var _ resource.ResourceWithImportState = &ResourceSnapshotExport{}
+
+// ResourceSnapshotExport defines the resource implementation.
+type ResourceSnapshotExport struct {
+	client *exoscale.Client
+}
+
+// NewResourceSnapshotExport creates instance of ResourceSnapshotExport.
+func NewResourceSnapshotExport() resource.Resource {
+	return &ResourceSnapshotExport{}
+}
+
+// ResourceSnapshotExportModel defines the resource data model.
+type ResourceSnapshotExportModel struct {
+	ID         types.String `tfsdk:"id"`
+	SnapshotID types.String `tfsdk:"snapshot_id"`
+	URL        types.String `tfsdk:"url"`
+	MD5Sum     types.String `tfsdk:"md5sum"`
+	Zone       types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSnapshotExport) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_instance_snapshot_export"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceSnapshotExport) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceSnapshotExportDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource (the exported snapshot ID).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "❗ The [exoscale_compute_instance_snapshot](./compute_instance_snapshot.md) ID to export.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The pre-signed URL to download the exported snapshot disk image.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5sum": schema.StringAttribute{
+				MarkdownDescription: "The MD5 checksum of the exported snapshot disk image.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceSnapshotExport) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceSnapshotExport) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSnapshotExportModel
+
+	// Load Terraform plan into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	snapshotID, err := exoscale.ParseUUID(plan.SnapshotID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse snapshot ID",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err := client.ExportSnapshot(ctx, snapshotID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to export instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to export instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update computed attributes before saving the state.
+	snapshot, err := client.GetSnapshot(ctx, snapshotID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	if snapshot.Export == nil {
+		resp.Diagnostics.AddError(
+			"failed to export instance snapshot",
+			"snapshot has no export information",
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(snapshot.ID.String())
+	readSnapshotExportIntoModel(snapshot.Export, &plan)
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]any{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceSnapshotExport) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSnapshotExportModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	// Read remote state.
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse snapshot ID",
+			err.Error(),
+		)
+		return
+	}
+
+	snapshot, err := client.GetSnapshot(ctx, id)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to get instance snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	// The export is gone (e.g. expired): let Terraform export the snapshot again.
+	if snapshot.Export == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state model.
+	state.SnapshotID = types.StringValue(snapshot.ID.String())
+	readSnapshotExportIntoModel(snapshot.Export, &state)
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]any{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceSnapshotExport) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ResourceSnapshotExportModel
+
+	// All attributes but timeouts force a replacement, nothing to update remotely.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceSnapshotExport) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSnapshotExportModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Snapshot exports cannot be revoked, the resource is only removed from the state.
+	tflog.Trace(ctx, "resource deleted", map[string]any{
+		"id": state.ID,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceSnapshotExport) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: snapshot_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceSnapshotExportModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.ID = types.StringValue(idParts[0])
+	state.Zone = types.StringValue(idParts[1])
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]any{
+		"id": state.ID,
+	})
+}
+
+func readSnapshotExportIntoModel(export *exoscale.SnapshotExport, data *ResourceSnapshotExportModel) {
+	data.URL = types.StringValue(export.PresignedURL)
+	data.MD5Sum = types.StringValue(export.Md5sum)
+}
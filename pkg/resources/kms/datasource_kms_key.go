@@ -0,0 +1,317 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionDataSourceKMSKey = `Fetch Exoscale KMS Key data.
+
+Corresponding resource: [exoscale_kms_key](../resources/kms_key.md).`
+
+var _ datasource.DataSourceWithConfigure = (*DataSourceKMSKey)(nil)
+
+type DataSourceKMSKey struct {
+	client *exoscale.Client
+}
+
+func NewDataSourceKMSKey() datasource.DataSource {
+	return &DataSourceKMSKey{}
+}
+
+type DataSourceKMSKeyModel struct {
+	ID           types.String            `tfsdk:"id"`
+	Name         types.String            `tfsdk:"name"`
+	Zone         types.String            `tfsdk:"zone"`
+	Description  types.String            `tfsdk:"description"`
+	MultiZone    types.Bool              `tfsdk:"multi_zone"`
+	Usage        types.String            `tfsdk:"usage"`
+	Status       types.String            `tfsdk:"status"`
+	Source       types.String            `tfsdk:"source"`
+	OriginZone   types.String            `tfsdk:"origin_zone"`
+	ReplicaZones types.Set               `tfsdk:"replica_zones"`
+	CreatedAt    types.String            `tfsdk:"created_at"`
+	Rotation     *KMSKeyRotationSettings `tfsdk:"rotation"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// KMSKeyRotationSettings holds the automatic rotation state of a KMS key.
+type KMSKeyRotationSettings struct {
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	PeriodDays     types.Int64  `tfsdk:"period_days"`
+	NextRotationAt types.String `tfsdk:"next_rotation_at"`
+	ManualCount    types.Int64  `tfsdk:"manual_count"`
+}
+
+func (d *DataSourceKMSKey) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_key"
+}
+
+func (d *DataSourceKMSKey) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dataSourceKMSKeyAttributes()
+
+	attributes["id"] = schema.StringAttribute{
+		Description:         "The KMS Key ID to match (conflicts with 'name').",
+		MarkdownDescription: "The KMS Key ID to match (conflicts with `name`).",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.Expressions{
+				path.MatchRoot("name"),
+			}...),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description:         "The KMS Key name to match (conflicts with 'id').",
+		MarkdownDescription: "The KMS Key name to match (conflicts with `id`).",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.Expressions{
+				path.MatchRoot("id"),
+			}...),
+		},
+	}
+	attributes["zone"] = schema.StringAttribute{
+		Description:         "The Exoscale zone name.",
+		MarkdownDescription: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(config.Zones...),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescriptionDataSourceKMSKey,
+		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourceKMSKey) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (d *DataSourceKMSKey) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataSourceKMSKeyModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, d.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	var id exoscale.UUID
+	if !state.ID.IsNull() {
+		id, err = exoscale.ParseUUID(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "unable to parse KMS key ID", err.Error())
+			return
+		}
+	} else {
+		keys, err := client.ListKmsKeys(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("API error listing KMS keys", err.Error())
+			return
+		}
+
+		entry, err := keys.FindListKmsKeysResponseEntry(state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("KMS key %q not found", state.Name.ValueString()), err.Error())
+			return
+		}
+		id = entry.ID
+	}
+
+	key, err := client.GetKmsKey(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("API error reading KMS key", err.Error())
+		return
+	}
+
+	// The key details share the list entry fields, reuse its conversion.
+	model, diags := kmsKeyModelFromListEntry(ctx, exoscale.ListKmsKeysResponseEntry{
+		CreatedAT:   key.CreatedAT,
+		Description: key.Description,
+		ID:          key.ID,
+		MultiZone:   key.MultiZone,
+		Name:        key.Name,
+		OriginZone:  key.OriginZone,
+		Replicas:    key.Replicas,
+		Rotation:    key.Rotation,
+		Source:      exoscale.ListKmsKeysResponseEntrySource(key.Source),
+		Status:      exoscale.ListKmsKeysResponseEntryStatus(key.Status),
+		Usage:       key.Usage,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = model.ID
+	state.Name = model.Name
+	state.Description = model.Description
+	state.MultiZone = model.MultiZone
+	state.Usage = model.Usage
+	state.Status = model.Status
+	state.Source = model.Source
+	state.OriginZone = model.OriginZone
+	state.ReplicaZones = model.ReplicaZones
+	state.CreatedAt = model.CreatedAt
+	state.Rotation = model.Rotation
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// dataSourceKMSKeyAttributes returns the computed attributes describing a KMS key.
+func dataSourceKMSKeyAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The KMS Key ID.",
+			MarkdownDescription: "The KMS Key ID.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "The KMS Key name.",
+			MarkdownDescription: "The KMS Key name.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			Description:         "The KMS Key description.",
+			MarkdownDescription: "The KMS Key description.",
+			Computed:            true,
+		},
+		"multi_zone": schema.BoolAttribute{
+			Description:         "Whether the key is replicated across multiple zones.",
+			MarkdownDescription: "Whether the key is replicated across multiple zones.",
+			Computed:            true,
+		},
+		"usage": schema.StringAttribute{
+			Description:         "The key usage purpose (e.g. encrypt-decrypt).",
+			MarkdownDescription: "The key usage purpose (e.g. `encrypt-decrypt`).",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			Description:         "The KMS Key status (enabled, disabled or pending-deletion).",
+			MarkdownDescription: "The KMS Key status (`enabled`, `disabled` or `pending-deletion`).",
+			Computed:            true,
+		},
+		"source": schema.StringAttribute{
+			Description:         "The origin of the key material.",
+			MarkdownDescription: "The origin of the key material.",
+			Computed:            true,
+		},
+		"origin_zone": schema.StringAttribute{
+			Description:         "The zone the KMS Key was created in.",
+			MarkdownDescription: "The zone the KMS Key was created in.",
+			Computed:            true,
+		},
+		"replica_zones": schema.SetAttribute{
+			Description:         "The zones the KMS Key is replicated into.",
+			MarkdownDescription: "The zones the KMS Key is replicated into.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			Description:         "The KMS Key creation date.",
+			MarkdownDescription: "The KMS Key creation date.",
+			Computed:            true,
+		},
+		"rotation": schema.SingleNestedAttribute{
+			Description:         "The automatic key material rotation settings.",
+			MarkdownDescription: "The automatic key material rotation settings.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					Description:         "Whether the key material is rotated automatically.",
+					MarkdownDescription: "Whether the key material is rotated automatically.",
+					Computed:            true,
+				},
+				"period_days": schema.Int64Attribute{
+					Description:         "The number of days between automatic rotations.",
+					MarkdownDescription: "The number of days between automatic rotations.",
+					Computed:            true,
+				},
+				"next_rotation_at": schema.StringAttribute{
+					Description:         "The date of the next automatic rotation.",
+					MarkdownDescription: "The date of the next automatic rotation.",
+					Computed:            true,
+				},
+				"manual_count": schema.Int64Attribute{
+					Description:         "The number of manual rotations performed.",
+					MarkdownDescription: "The number of manual rotations performed.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// kmsKeyModelFromListEntry converts a KMS key API entry to its data source model.
+func kmsKeyModelFromListEntry(ctx context.Context, key exoscale.ListKmsKeysResponseEntry) (KMSKeyModel, diag.Diagnostics) {
+	replicaZones, diags := types.SetValueFrom(ctx, types.StringType, key.Replicas)
+
+	model := KMSKeyModel{
+		ID:           types.StringValue(key.ID.String()),
+		Name:         types.StringValue(key.Name),
+		Description:  types.StringValue(key.Description),
+		MultiZone:    types.BoolPointerValue(key.MultiZone),
+		Usage:        types.StringValue(key.Usage),
+		Status:       types.StringValue(string(key.Status)),
+		Source:       types.StringValue(string(key.Source)),
+		OriginZone:   types.StringValue(key.OriginZone),
+		ReplicaZones: replicaZones,
+		CreatedAt:    types.StringValue(key.CreatedAT.Format(time.RFC3339)),
+	}
+
+	if key.Rotation != nil {
+		model.Rotation = &KMSKeyRotationSettings{
+			Enabled:        types.BoolPointerValue(key.Rotation.Automatic),
+			PeriodDays:     types.Int64Value(int64(key.Rotation.RotationPeriod)),
+			NextRotationAt: types.StringNull(),
+			ManualCount:    types.Int64Value(int64(key.Rotation.ManualCount)),
+		}
+		if model.Rotation.Enabled.ValueBool() && !key.Rotation.NextAT.IsZero() {
+			model.Rotation.NextRotationAt = types.StringValue(key.Rotation.NextAT.Format(time.RFC3339))
+		}
+	}
+
+	return model, diags
+}
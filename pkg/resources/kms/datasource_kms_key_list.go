@@ -0,0 +1,141 @@
+package kms
+
+import (
+	"context"
+	"sort"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionDataSourceKMSKeyList = `List Exoscale KMS Keys.
+
+Corresponding resource: [exoscale_kms_key](../resources/kms_key.md).`
+
+var _ datasource.DataSourceWithConfigure = (*DataSourceKMSKeyList)(nil)
+
+type DataSourceKMSKeyList struct {
+	client *exoscale.Client
+}
+
+func NewDataSourceKMSKeyList() datasource.DataSource {
+	return &DataSourceKMSKeyList{}
+}
+
+type DataSourceKMSKeyListModel struct {
+	Zone types.String  `tfsdk:"zone"`
+	Keys []KMSKeyModel `tfsdk:"keys"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// KMSKeyModel holds the data of a KMS key.
+type KMSKeyModel struct {
+	ID           types.String            `tfsdk:"id"`
+	Name         types.String            `tfsdk:"name"`
+	Description  types.String            `tfsdk:"description"`
+	MultiZone    types.Bool              `tfsdk:"multi_zone"`
+	Usage        types.String            `tfsdk:"usage"`
+	Status       types.String            `tfsdk:"status"`
+	Source       types.String            `tfsdk:"source"`
+	OriginZone   types.String            `tfsdk:"origin_zone"`
+	ReplicaZones types.Set               `tfsdk:"replica_zones"`
+	CreatedAt    types.String            `tfsdk:"created_at"`
+	Rotation     *KMSKeyRotationSettings `tfsdk:"rotation"`
+}
+
+func (d *DataSourceKMSKeyList) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_key_list"
+}
+
+func (d *DataSourceKMSKeyList) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescriptionDataSourceKMSKeyList,
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Description:         "The Exoscale zone name.",
+				MarkdownDescription: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"keys": schema.ListNestedAttribute{
+				Description:         "The KMS Keys available in the zone (sorted by name).",
+				MarkdownDescription: "The KMS Keys available in the zone (sorted by name).",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dataSourceKMSKeyAttributes(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourceKMSKeyList) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (d *DataSourceKMSKeyList) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataSourceKMSKeyListModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, d.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	keys, err := client.ListKmsKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API error listing KMS keys", err.Error())
+		return
+	}
+
+	state.Keys = make([]KMSKeyModel, 0, len(keys.KmsKeys))
+	for _, key := range keys.KmsKeys {
+		model, diags := kmsKeyModelFromListEntry(ctx, key)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Keys = append(state.Keys, model)
+	}
+
+	sort.Slice(state.Keys, func(i, j int) bool {
+		return state.Keys[i].Name.ValueString() < state.Keys[j].Name.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -2,6 +2,7 @@ package kms_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -37,6 +38,7 @@ func TestKMSKey(t *testing.T) {
 					resource.TestCheckResourceAttr(fullResourceName, "zone", testutils.TestZoneName),
 					resource.TestCheckResourceAttr(fullResourceName, "usage", "encrypt-decrypt"),
 					resource.TestCheckResourceAttr(fullResourceName, "status", "enabled"),
+					resource.TestCheckResourceAttr(fullResourceName, "enabled", "true"),
 				),
 			},
 			// Import
@@ -54,6 +56,87 @@ func TestKMSKey(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Update
+			{
+				Config: testutils.ParseTestdataConfig(
+					"./testdata/002.kms_key_update.tf.tmpl",
+					&testdataSpec,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fullResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(fullResourceName, "status", "disabled"),
+					resource.TestCheckResourceAttr(fullResourceName, "rotation.enabled", "true"),
+					resource.TestCheckResourceAttr(fullResourceName, "rotation.period_days", "90"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "rotation.next_rotation_at"),
+					resource.TestCheckResourceAttrSet(fullResourceName, "rotations.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestKMSKeyReplicaZonesRequireMultiZone(t *testing.T) {
+	t.Parallel()
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ParseTestdataConfig(
+					"./testdata/004.kms_key_invalid_replicas.tf.tmpl",
+					&testdataSpec,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`requires "multi_zone" to be set to true`),
+			},
+		},
+	})
+}
+
+func TestKMSKeyDataSources(t *testing.T) {
+	t.Parallel()
+
+	fullResourceName := "exoscale_kms_key.test"
+	dataSourceByID := "data.exoscale_kms_key.by_id"
+	dataSourceByName := "data.exoscale_kms_key.by_name"
+	dataSourceList := "data.exoscale_kms_key_list.test"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ParseTestdataConfig(
+					"./testdata/003.kms_key_datasources.tf.tmpl",
+					&testdataSpec,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByID, "id", fullResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByID, "name", fullResourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceByID, "description", "acceptance test key"),
+					resource.TestCheckResourceAttr(dataSourceByID, "status", "enabled"),
+					resource.TestCheckResourceAttr(dataSourceByID, "usage", "encrypt-decrypt"),
+					resource.TestCheckResourceAttr(dataSourceByID, "origin_zone", testutils.TestZoneName),
+					resource.TestCheckResourceAttrSet(dataSourceByID, "source"),
+					resource.TestCheckResourceAttrSet(dataSourceByID, "rotation.enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceByName, "id", fullResourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceList, "keys.*", map[string]string{
+						"name":   testutils.ResourceName(testdataSpec.ID),
+						"status": "enabled",
+					}),
+				),
+			},
 		},
 	})
 }
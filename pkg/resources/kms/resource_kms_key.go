@@ -4,16 +4,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	exoscale "github.com/exoscale/egoscale/v3"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -26,6 +35,7 @@ import (
 
 var _ resource.Resource = &ResourceKMSKey{}
 var _ resource.ResourceWithImportState = &ResourceKMSKey{}
+var _ resource.ResourceWithValidateConfig = &ResourceKMSKey{}
 
 // kmsKeyDeletionDelayDays is the minimum scheduled deletion delay accepted by the KMS API.
 // Keys cannot be deleted immediately; they enter a pending-deletion state for at least this many days.
@@ -40,10 +50,36 @@ type ResourceKMSKeyModel struct {
 	MultiZone   types.Bool   `tfsdk:"multi_zone"`
 	Usage       types.String `tfsdk:"usage"`
 	Status      types.String `tfsdk:"status"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+
+	ReplicaZones    types.Set                    `tfsdk:"replica_zones"`
+	Rotation        *ResourceKMSKeyRotationModel `tfsdk:"rotation"`
+	RotationTrigger types.String                 `tfsdk:"rotation_trigger"`
+	Rotations       types.List                   `tfsdk:"rotations"`
 
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
+// ResourceKMSKeyRotationModel holds the automatic rotation settings of a KMS key.
+type ResourceKMSKeyRotationModel struct {
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	PeriodDays     types.Int64  `tfsdk:"period_days"`
+	NextRotationAt types.String `tfsdk:"next_rotation_at"`
+}
+
+// KMSKeyRotationModel holds an entry of the key material rotation history.
+type KMSKeyRotationModel struct {
+	Version   types.Int64  `tfsdk:"version"`
+	Automatic types.Bool   `tfsdk:"automatic"`
+	RotatedAt types.String `tfsdk:"rotated_at"`
+}
+
+var kmsKeyRotationAttrTypes = map[string]attr.Type{
+	"version":    types.Int64Type,
+	"automatic":  types.BoolType,
+	"rotated_at": types.StringType,
+}
+
 type ResourceKMSKey struct {
 	client *exoscale.Client
 }
@@ -58,7 +94,7 @@ func (r *ResourceKMSKey) Metadata(_ context.Context, req resource.MetadataReques
 
 func (r *ResourceKMSKey) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manage Exoscale KMS Keys.",
+		MarkdownDescription: "Manage Exoscale KMS Keys.\n\nCorresponding data sources: [exoscale_kms_key](../data-sources/kms_key.md), [exoscale_kms_key_list](../data-sources/kms_key_list.md).",
 		Description:         "Manage Exoscale KMS Keys.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -123,8 +159,87 @@ func (r *ResourceKMSKey) Schema(ctx context.Context, _ resource.SchemaRequest, r
 				Description:         "The current status of the KMS Key.",
 				Computed:            true,
 			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the KMS Key can be used for cryptographic operations (default: `true`).",
+				Description:         "Whether the KMS Key can be used for cryptographic operations (default: true).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"replica_zones": schema.SetAttribute{
+				MarkdownDescription: "Additional [Zones](https://www.exoscale.com/datacenters/) to replicate the KMS Key into, `multi_zone` must be enabled. Replicas cannot be removed.",
+				Description:         "Additional Zones to replicate the KMS Key into, multi_zone must be enabled. Replicas cannot be removed.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					replicaZonesNotRemoved{},
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(config.Zones...)),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value, changing it rotates the key material immediately (e.g. a date or a [time_rotating](https://registry.terraform.io/providers/hashicorp/time/latest/docs/resources/rotating) ID).",
+				Description:         "An arbitrary value, changing it rotates the key material immediately.",
+				Optional:            true,
+			},
+			"rotations": schema.ListNestedAttribute{
+				MarkdownDescription: "The key material rotation history.",
+				Description:         "The key material rotation history.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "The key material version.",
+							Description:         "The key material version.",
+							Computed:            true,
+						},
+						"automatic": schema.BoolAttribute{
+							MarkdownDescription: "Whether the rotation was performed automatically.",
+							Description:         "Whether the rotation was performed automatically.",
+							Computed:            true,
+						},
+						"rotated_at": schema.StringAttribute{
+							MarkdownDescription: "The rotation date.",
+							Description:         "The rotation date.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"rotation": schema.SingleNestedBlock{
+				MarkdownDescription: "Automatic key material rotation settings. When omitted, the rotation settings are left untouched.",
+				Description:         "Automatic key material rotation settings. When omitted, the rotation settings are left untouched.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the key material is rotated automatically (default: `true`).",
+						Description:         "Whether the key material is rotated automatically (default: true).",
+						Optional:            true,
+						Computed:            true,
+					},
+					"period_days": schema.Int64Attribute{
+						MarkdownDescription: "The number of days between automatic rotations (`90`-`2560`).",
+						Description:         "The number of days between automatic rotations (90-2560).",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							int64validator.Between(90, 2560),
+						},
+					},
+					"next_rotation_at": schema.StringAttribute{
+						MarkdownDescription: "The date of the next automatic rotation.",
+						Description:         "The date of the next automatic rotation.",
+						Computed:            true,
+					},
+				},
+			},
 			"timeouts": timeouts.BlockAll(ctx),
 		},
 	}
@@ -137,6 +252,29 @@ func (r *ResourceKMSKey) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
 }
 
+func (r *ResourceKMSKey) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var replicaZones types.Set
+	var multiZone types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("replica_zones"), &replicaZones)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("multi_zone"), &multiZone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if replicaZones.IsNull() || replicaZones.IsUnknown() || len(replicaZones.Elements()) == 0 || multiZone.IsUnknown() {
+		return
+	}
+
+	// Only multi-zone keys can be replicated.
+	if !multiZone.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("replica_zones"),
+			"Invalid attribute combination",
+			`Attribute "replica_zones" requires "multi_zone" to be set to true`,
+		)
+	}
+}
+
 func (r *ResourceKMSKey) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ResourceKMSKeyModel
 
@@ -180,16 +318,32 @@ func (r *ResourceKMSKey) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	plan.ID = types.StringValue(key.ID.String())
-	plan.Status = types.StringValue(string(key.Status))
 
-	if plan.Usage.IsUnknown() {
-		plan.Usage = types.StringValue(key.Usage)
+	if plan.Rotation != nil && kmsKeyRotationEnabled(plan.Rotation) {
+		resp.Diagnostics.Append(updateKMSKeyRotation(ctx, client, key.ID, plan.Rotation)...)
+	}
+
+	if !plan.ReplicaZones.IsUnknown() {
+		var zones []string
+		resp.Diagnostics.Append(plan.ReplicaZones.ElementsAs(ctx, &zones, false)...)
+		resp.Diagnostics.Append(replicateKMSKey(ctx, client, key.ID, zones)...)
+	}
+
+	if !plan.Enabled.ValueBool() {
+		if _, err := client.DisableKmsKey(ctx, key.ID); err != nil {
+			resp.Diagnostics.AddError("API error disabling KMS key", err.Error())
+		}
 	}
-	if plan.MultiZone.IsUnknown() {
-		plan.MultiZone = types.BoolValue(*key.MultiZone)
+
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.readReplicating(ctx, client, key.ID, &plan)...)
 	}
-	if plan.Description.IsUnknown() {
-		plan.Description = types.StringValue(key.Description)
+
+	// Save the key ID even if the post-creation steps failed, the resource will be tainted.
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), plan.Zone)...)
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -241,17 +395,99 @@ func (r *ResourceKMSKey) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	state.Name = types.StringValue(key.Name)
-	state.Status = types.StringValue(string(key.Status))
-	state.Usage = types.StringValue(key.Usage)
-	state.MultiZone = types.BoolValue(*key.MultiZone)
-	state.Description = types.StringValue(key.Description)
+	resp.Diagnostics.Append(r.refresh(ctx, client, key, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Update is a no-op: all mutable attributes use RequiresReplace.
-func (r *ResourceKMSKey) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (r *ResourceKMSKey) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ResourceKMSKeyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse resource ID", err.Error())
+		return
+	}
+
+	// A disabled key must be enabled before any other change, and disabled last.
+	if plan.Enabled.ValueBool() && !state.Enabled.ValueBool() {
+		if _, err := client.EnableKmsKey(ctx, id); err != nil {
+			resp.Diagnostics.AddError("API error enabling KMS key", err.Error())
+			return
+		}
+	}
+
+	if plan.Rotation != nil && kmsKeyRotationChanged(plan.Rotation, state.Rotation) {
+		resp.Diagnostics.Append(updateKMSKeyRotation(ctx, client, id, plan.Rotation)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.RotationTrigger.Equal(state.RotationTrigger) && !plan.RotationTrigger.IsNull() {
+		if _, err := client.RotateKmsKey(ctx, id); err != nil {
+			resp.Diagnostics.AddError("API error rotating KMS key", err.Error())
+			return
+		}
+	}
+
+	if !plan.ReplicaZones.IsUnknown() && !plan.ReplicaZones.Equal(state.ReplicaZones) {
+		var planZones, stateZones []string
+		resp.Diagnostics.Append(plan.ReplicaZones.ElementsAs(ctx, &planZones, false)...)
+		resp.Diagnostics.Append(state.ReplicaZones.ElementsAs(ctx, &stateZones, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var added []string
+		for _, zone := range planZones {
+			if !slices.Contains(stateZones, zone) {
+				added = append(added, zone)
+			}
+		}
+
+		resp.Diagnostics.Append(replicateKMSKey(ctx, client, id, added)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Enabled.ValueBool() && state.Enabled.ValueBool() {
+		if _, err := client.DisableKmsKey(ctx, id); err != nil {
+			resp.Diagnostics.AddError("API error disabling KMS key", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readReplicating(ctx, client, id, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ResourceKMSKey) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -313,8 +549,184 @@ func (r *ResourceKMSKey) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceKMSKeyModel{
-		ID:       types.StringValue(idParts[0]),
-		Zone:     types.StringValue(idParts[1]),
-		Timeouts: t,
+		ID:              types.StringValue(idParts[0]),
+		Zone:            types.StringValue(idParts[1]),
+		Enabled:         types.BoolNull(),
+		ReplicaZones:    types.SetNull(types.StringType),
+		RotationTrigger: types.StringNull(),
+		Rotations:       types.ListNull(types.ObjectType{AttrTypes: kmsKeyRotationAttrTypes}),
+		Timeouts:        t,
 	})...)
 }
+
+// read fetches the KMS key and refreshes the model from it.
+func (r *ResourceKMSKey) read(ctx context.Context, client *exoscale.Client, id exoscale.UUID, data *ResourceKMSKeyModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	key, err := client.GetKmsKey(ctx, id)
+	if err != nil {
+		diags.AddError("API error reading KMS key", err.Error())
+		return diags
+	}
+
+	return r.refresh(ctx, client, key, data)
+}
+
+// readReplicating is read, keeping the planned replica zones: the API only lists
+// the replicas once their replication is complete.
+func (r *ResourceKMSKey) readReplicating(ctx context.Context, client *exoscale.Client, id exoscale.UUID, data *ResourceKMSKeyModel) diag.Diagnostics {
+	replicaZones := data.ReplicaZones
+
+	diags := r.read(ctx, client, id, data)
+	if !replicaZones.IsUnknown() && !replicaZones.IsNull() {
+		data.ReplicaZones = replicaZones
+	}
+
+	return diags
+}
+
+// refresh updates the model from the KMS key and its rotation history.
+// The rotation settings are only tracked when the rotation block is configured.
+func (r *ResourceKMSKey) refresh(ctx context.Context, client *exoscale.Client, key *exoscale.GetKmsKeyResponse, data *ResourceKMSKeyModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringValue(key.Name)
+	data.Status = types.StringValue(string(key.Status))
+	data.Enabled = types.BoolValue(key.Status != exoscale.GetKmsKeyResponseStatusDisabled)
+	data.Usage = types.StringValue(key.Usage)
+	data.MultiZone = types.BoolValue(*key.MultiZone)
+	data.Description = types.StringValue(key.Description)
+
+	replicaZones, dg := types.SetValueFrom(ctx, types.StringType, key.Replicas)
+	diags.Append(dg...)
+	data.ReplicaZones = replicaZones
+
+	if data.Rotation != nil {
+		data.Rotation.Enabled = types.BoolValue(false)
+		data.Rotation.PeriodDays = types.Int64Null()
+		data.Rotation.NextRotationAt = types.StringNull()
+
+		if key.Rotation != nil {
+			data.Rotation.Enabled = types.BoolPointerValue(key.Rotation.Automatic)
+			data.Rotation.PeriodDays = types.Int64Value(int64(key.Rotation.RotationPeriod))
+			if data.Rotation.Enabled.ValueBool() && !key.Rotation.NextAT.IsZero() {
+				data.Rotation.NextRotationAt = types.StringValue(key.Rotation.NextAT.Format(time.RFC3339))
+			}
+		}
+	}
+
+	rotations, err := client.ListKmsKeyRotations(ctx, key.ID)
+	if err != nil {
+		diags.AddError("API error listing KMS key rotations", err.Error())
+		return diags
+	}
+
+	history := make([]KMSKeyRotationModel, 0, len(rotations.Rotations))
+	for _, rotation := range rotations.Rotations {
+		history = append(history, KMSKeyRotationModel{
+			Version:   types.Int64Value(int64(rotation.Version)),
+			Automatic: types.BoolPointerValue(rotation.Automatic),
+			RotatedAt: types.StringValue(rotation.RotatedAT.Format(time.RFC3339)),
+		})
+	}
+	slices.SortFunc(history, func(a, b KMSKeyRotationModel) int {
+		return int(a.Version.ValueInt64() - b.Version.ValueInt64())
+	})
+
+	data.Rotations, dg = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: kmsKeyRotationAttrTypes}, history)
+	diags.Append(dg...)
+
+	return diags
+}
+
+// updateKMSKeyRotation enables or disables the automatic rotation of a KMS key.
+func updateKMSKeyRotation(ctx context.Context, client *exoscale.Client, id exoscale.UUID, rotation *ResourceKMSKeyRotationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !kmsKeyRotationEnabled(rotation) {
+		if _, err := client.DisableKmsKeyRotation(ctx, id); err != nil {
+			diags.AddError("API error disabling KMS key rotation", err.Error())
+		}
+		return diags
+	}
+
+	req := exoscale.EnableKmsKeyRotationRequest{}
+	if !rotation.PeriodDays.IsUnknown() && !rotation.PeriodDays.IsNull() {
+		req.RotationPeriod = int(rotation.PeriodDays.ValueInt64())
+	}
+
+	if _, err := client.EnableKmsKeyRotation(ctx, id, req); err != nil {
+		diags.AddError("API error enabling KMS key rotation", err.Error())
+	}
+
+	return diags
+}
+
+// replicateKMSKey replicates a KMS key into the given zones.
+func replicateKMSKey(ctx context.Context, client *exoscale.Client, id exoscale.UUID, zones []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, zone := range zones {
+		if _, err := client.ReplicateKmsKey(ctx, id, exoscale.ReplicateKmsKeyRequest{Zone: zone}); err != nil {
+			diags.AddError(fmt.Sprintf("API error replicating KMS key to zone %s", zone), err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// kmsKeyRotationChanged reports whether the planned rotation settings differ from the state.
+func kmsKeyRotationChanged(plan, state *ResourceKMSKeyRotationModel) bool {
+	if state == nil {
+		return true
+	}
+
+	if kmsKeyRotationEnabled(plan) != state.Enabled.ValueBool() {
+		return true
+	}
+
+	return !plan.PeriodDays.IsUnknown() && !plan.PeriodDays.Equal(state.PeriodDays)
+}
+
+// kmsKeyRotationEnabled reports whether the automatic rotation is requested,
+// which is the case unless explicitly disabled.
+func kmsKeyRotationEnabled(rotation *ResourceKMSKeyRotationModel) bool {
+	return rotation.Enabled.IsNull() || rotation.Enabled.IsUnknown() || rotation.Enabled.ValueBool()
+}
+
+// replicaZonesNotRemoved rejects the removal of a replica zone at plan time: replicas
+// cannot be deleted, and replacing the key would schedule the deletion of its material.
+type replicaZonesNotRemoved struct{}
+
+func (m replicaZonesNotRemoved) Description(_ context.Context) string {
+	return "Rejects the removal of KMS Key replicas, which cannot be deleted."
+}
+
+func (m replicaZonesNotRemoved) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m replicaZonesNotRemoved) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.PlanValue.IsUnknown() || req.StateValue.IsNull() {
+		return
+	}
+
+	var planZones, stateZones []string
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &planZones, false)...)
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &stateZones, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, zone := range stateZones {
+		if !slices.Contains(planZones, zone) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Replicas cannot be removed",
+				fmt.Sprintf("The KMS Key is replicated in zone %s, replicas cannot be removed from a KMS Key.", zone),
+			)
+			return
+		}
+	}
+}
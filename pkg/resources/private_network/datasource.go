@@ -41,6 +41,9 @@ type DataSourceModel struct {
 	StartIP     types.String `tfsdk:"start_ip"`
 	EndIP       types.String `tfsdk:"end_ip"`
 	Netmask     types.String `tfsdk:"netmask"`
+	Options     types.Object `tfsdk:"options"`
+	Vni         types.Int64  `tfsdk:"vni"`
+	Leases      types.List   `tfsdk:"leases"`
 
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -110,6 +113,61 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				MarkdownDescription: "The network mask defining the IPv4 network allowed for static leases.",
 				Computed:            true,
 			},
+			"options": schema.SingleNestedAttribute{
+				Description:         "The DHCP options advertised to compute instances.",
+				MarkdownDescription: "The DHCP options advertised to compute instances.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"dns_servers": schema.ListAttribute{
+						Description:         "A list of DNS servers IP addresses.",
+						MarkdownDescription: "A list of DNS servers IP addresses.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"ntp_servers": schema.ListAttribute{
+						Description:         "A list of NTP servers IP addresses.",
+						MarkdownDescription: "A list of NTP servers IP addresses.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"routers": schema.ListAttribute{
+						Description:         "A list of routers IP addresses (default gateways).",
+						MarkdownDescription: "A list of routers IP addresses (default gateways).",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"domain_search": schema.ListAttribute{
+						Description:         "A list of domains to use as DNS search suffixes.",
+						MarkdownDescription: "A list of domains to use as DNS search suffixes.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+				},
+			},
+			"vni": schema.Int64Attribute{
+				Description:         "The private network VXLAN ID.",
+				MarkdownDescription: "The private network VXLAN ID.",
+				Computed:            true,
+			},
+			"leases": schema.ListNestedAttribute{
+				Description:         "The IPv4 addresses leased to compute instances.",
+				MarkdownDescription: "The IPv4 addresses leased to compute instances.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Description:         "The compute instance ID.",
+							MarkdownDescription: "The [exoscale_compute_instance](../resources/compute_instance.md) ID.",
+							Computed:            true,
+						},
+						"ip": schema.StringAttribute{
+							Description:         "The IPv4 address leased to the instance.",
+							MarkdownDescription: "The IPv4 address leased to the instance.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -207,8 +265,24 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		StartIP:     types.StringValue(privateNetwork.StartIP.String()),
 		EndIP:       types.StringValue(privateNetwork.EndIP.String()),
 		Netmask:     types.StringValue(privateNetwork.Netmask.String()),
+		Vni:         types.Int64Value(privateNetwork.Vni),
 		Timeouts:    state.Timeouts,
 	}
+
+	options, dg := optionsToModel(ctx, privateNetwork.Options, false)
+	if dg.HasError() {
+		resp.Diagnostics.Append(dg...)
+		return
+	}
+	state.Options = options
+
+	leases, dg := leasesToModel(ctx, privateNetwork.Leases)
+	if dg.HasError() {
+		resp.Diagnostics.Append(dg...)
+		return
+	}
+	state.Leases = leases
+
 	state.Labels = types.MapNull(types.StringType)
 	if privateNetwork.Labels != nil {
 		labels, dg := types.MapValueFrom(
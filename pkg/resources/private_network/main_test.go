@@ -7,6 +7,7 @@ import (
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
 	tftest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -38,6 +39,12 @@ func Test_Resource_Private_Network(t *testing.T) {
 					tftest.TestCheckResourceAttr(resource, "netmask", "255.255.255.0"),
 					tftest.TestCheckResourceAttr(resource, "labels.%", "1"),
 					tftest.TestCheckResourceAttr(resource, "labels.A", "B"),
+					tftest.TestCheckResourceAttr(resource, "options.dns_servers.#", "1"),
+					tftest.TestCheckResourceAttr(resource, "options.dns_servers.0", "10.0.0.2"),
+					tftest.TestCheckResourceAttr(resource, "options.routers.0", "10.0.0.1"),
+					tftest.TestCheckResourceAttr(resource, "options.domain_search.0", "example.net"),
+					tftest.TestCheckResourceAttrSet(resource, "vni"),
+					tftest.TestCheckResourceAttr(resource, "leases.#", "0"),
 
 					// test datasource
 					tftest.TestCheckResourceAttrPair(resource, "name", datasourceByID, "name"),
@@ -46,6 +53,10 @@ func Test_Resource_Private_Network(t *testing.T) {
 					tftest.TestCheckResourceAttrPair(resource, "end_ip", datasourceByID, "end_ip"),
 					tftest.TestCheckResourceAttrPair(resource, "netmask", datasourceByID, "netmask"),
 					tftest.TestCheckResourceAttrPair(resource, "labels.A", datasourceByID, "labels.A"),
+					tftest.TestCheckResourceAttrPair(resource, "options.dns_servers.0", datasourceByID, "options.dns_servers.0"),
+					tftest.TestCheckResourceAttrPair(resource, "options.routers.0", datasourceByID, "options.routers.0"),
+					tftest.TestCheckResourceAttrPair(resource, "vni", datasourceByID, "vni"),
+					tftest.TestCheckResourceAttrPair(resource, "leases.#", datasourceByID, "leases.#"),
 
 					tftest.TestCheckResourceAttrPair(resource, "name", datasourceByName, "name"),
 					tftest.TestCheckResourceAttrPair(resource, "description", datasourceByName, "description"),
@@ -68,6 +79,9 @@ func Test_Resource_Private_Network(t *testing.T) {
 					tftest.TestCheckResourceAttr(resource, "netmask", "255.255.0.0"),
 					tftest.TestCheckResourceAttr(resource, "labels.%", "1"),
 					tftest.TestCheckResourceAttr(resource, "labels.A", "C"),
+					tftest.TestCheckResourceAttr(resource, "options.dns_servers.#", "2"),
+					tftest.TestCheckResourceAttr(resource, "options.ntp_servers.0", "10.0.0.4"),
+					tftest.TestCheckNoResourceAttr(resource, "options.domain_search"),
 				),
 			},
 
@@ -80,6 +94,69 @@ func Test_Resource_Private_Network(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+
+			// test clearing the DHCP options
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/005.pn_clear_options.tf.tmpl", &testDataSpec),
+				Check: tftest.ComposeAggregateTestCheckFunc(
+					tftest.TestCheckNoResourceAttr(resource, "options.dns_servers.#"),
+					tftest.TestCheckNoResourceAttr(resource, "options.ntp_servers.#"),
+					tftest.TestCheckNoResourceAttr(resource, "options.routers.#"),
+				),
+			},
+		},
+	})
+}
+
+func Test_Resource_Private_Network_Attachment(t *testing.T) {
+	t.Parallel()
+
+	resource := "exoscale_private_network_attachment.test_attachment"
+	resourceNetwork := "exoscale_private_network.test_pn"
+	resourceInstance := "exoscale_compute_instance.test_instance"
+
+	testDataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	tftest.Test(t, tftest.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []tftest.TestStep{
+			// Attach instance
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/003.pn_attachment_create.tf.tmpl", &testDataSpec),
+				Check: tftest.ComposeAggregateTestCheckFunc(
+					tftest.TestCheckResourceAttrPair(resource, "network_id", resourceNetwork, "id"),
+					tftest.TestCheckResourceAttrPair(resource, "instance_id", resourceInstance, "id"),
+					tftest.TestCheckResourceAttr(resource, "ip_address", "10.0.0.100"),
+					tftest.TestCheckResourceAttrSet(resource, "mac_address"),
+				),
+			},
+
+			// Update IP address in place
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/004.pn_attachment_update.tf.tmpl", &testDataSpec),
+				ConfigPlanChecks: tftest.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resource, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: tftest.ComposeAggregateTestCheckFunc(
+					tftest.TestCheckResourceAttr(resource, "ip_address", "10.0.0.101"),
+				),
+			},
+
+			// Import attachment
+			{
+				ResourceName: resource,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s@%s", s.RootModule().Resources[resource].Primary.ID, testDataSpec.Zone), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,173 @@
+package privatenetwork
+
+import (
+	"context"
+	"net"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// OptionsModel defines the DHCP options nested data model.
+type OptionsModel struct {
+	DNSServers   types.List `tfsdk:"dns_servers"`
+	NTPServers   types.List `tfsdk:"ntp_servers"`
+	Routers      types.List `tfsdk:"routers"`
+	DomainSearch types.List `tfsdk:"domain_search"`
+}
+
+// Types returns nested data model types to be used for conversion.
+func (m OptionsModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"dns_servers":   types.ListType{ElemType: types.StringType},
+		"ntp_servers":   types.ListType{ElemType: types.StringType},
+		"routers":       types.ListType{ElemType: types.StringType},
+		"domain_search": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// LeaseModel defines the DHCP lease nested data model.
+type LeaseModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	IP         types.String `tfsdk:"ip"`
+}
+
+// Types returns nested data model types to be used for conversion.
+func (m LeaseModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"instance_id": types.StringType,
+		"ip":          types.StringType,
+	}
+}
+
+// optionsFromModel converts the Terraform options object to API DHCP options.
+// A null object is converted to nil.
+func optionsFromModel(ctx context.Context, obj types.Object) (*exoscale.PrivateNetworkOptions, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+
+	var (
+		model   OptionsModel
+		options exoscale.PrivateNetworkOptions
+		diags   diag.Diagnostics
+	)
+
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ipsFromList := func(l types.List) []net.IP {
+		var values []string
+		diags.Append(l.ElementsAs(ctx, &values, false)...)
+
+		ips := make([]net.IP, 0, len(values))
+		for _, v := range values {
+			ips = append(ips, net.ParseIP(v))
+		}
+		return ips
+	}
+
+	options.DNSServers = ipsFromList(model.DNSServers)
+	options.NtpServers = ipsFromList(model.NTPServers)
+	options.Routers = ipsFromList(model.Routers)
+	diags.Append(model.DomainSearch.ElementsAs(ctx, &options.DomainSearch, false)...)
+
+	return &options, diags
+}
+
+// optionsBody holds the DHCP options as sent to the API. Unlike exoscale.PrivateNetworkOptions,
+// its lists are never omitted, so that removed options are cleared remotely.
+type optionsBody struct {
+	DNSServers   []net.IP `json:"dns-servers"`
+	DomainSearch []string `json:"domain-search"`
+	NTPServers   []net.IP `json:"ntp-servers"`
+	Routers      []net.IP `json:"routers"`
+}
+
+// newOptionsBody converts API DHCP options to their request body, with explicit empty lists.
+func newOptionsBody(options *exoscale.PrivateNetworkOptions) optionsBody {
+	body := optionsBody{
+		DNSServers:   []net.IP{},
+		DomainSearch: []string{},
+		NTPServers:   []net.IP{},
+		Routers:      []net.IP{},
+	}
+
+	if options != nil {
+		body.DNSServers = append(body.DNSServers, options.DNSServers...)
+		body.DomainSearch = append(body.DomainSearch, options.DomainSearch...)
+		body.NTPServers = append(body.NTPServers, options.NtpServers...)
+		body.Routers = append(body.Routers, options.Routers...)
+	}
+
+	return body
+}
+
+// optionsToModel converts API DHCP options to a Terraform options object.
+// Empty options are reported as null, unless keepEmpty is set
+// (i.e. an empty options block is present in the configuration).
+func optionsToModel(ctx context.Context, options *exoscale.PrivateNetworkOptions, keepEmpty bool) (types.Object, diag.Diagnostics) {
+	if options == nil {
+		options = &exoscale.PrivateNetworkOptions{}
+	}
+
+	if !keepEmpty &&
+		len(options.DNSServers) == 0 &&
+		len(options.NtpServers) == 0 &&
+		len(options.Routers) == 0 &&
+		len(options.DomainSearch) == 0 {
+		return types.ObjectNull(OptionsModel{}.Types()), nil
+	}
+
+	var diags diag.Diagnostics
+
+	ipsToList := func(ips []net.IP) types.List {
+		if len(ips) == 0 {
+			return types.ListNull(types.StringType)
+		}
+
+		values := make([]string, len(ips))
+		for i, ip := range ips {
+			values[i] = ip.String()
+		}
+
+		l, d := types.ListValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		return l
+	}
+
+	model := OptionsModel{
+		DNSServers:   ipsToList(options.DNSServers),
+		NTPServers:   ipsToList(options.NtpServers),
+		Routers:      ipsToList(options.Routers),
+		DomainSearch: types.ListNull(types.StringType),
+	}
+	if len(options.DomainSearch) > 0 {
+		l, d := types.ListValueFrom(ctx, types.StringType, options.DomainSearch)
+		diags.Append(d...)
+		model.DomainSearch = l
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, model.Types(), model)
+	diags.Append(d...)
+
+	return obj, diags
+}
+
+// leasesToModel converts API DHCP leases to a Terraform list of lease objects.
+func leasesToModel(ctx context.Context, leases []exoscale.PrivateNetworkLease) (types.List, diag.Diagnostics) {
+	models := make([]LeaseModel, len(leases))
+	for i, lease := range leases {
+		models[i] = LeaseModel{
+			InstanceID: types.StringValue(lease.InstanceID.String()),
+			IP:         ipStringValue(lease.IP),
+		}
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: LeaseModel{}.Types()}, models)
+}
@@ -0,0 +1,509 @@
+package privatenetwork
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const markdownDescriptionResourceAttachment = `Attach an Exoscale [Compute Instance](./compute_instance.md) to a [Private Network](./private_network.md).
+
+This resource allows managing the attachment independently from the instance, e.g. from a different module.
+The static IP address lease of the instance (*managed* private networks only) can be changed without detaching the instance.
+
+!> **WARNING:** do not use this resource together with the ` + "`network_interface`" + ` block of the [exoscale_compute_instance](./compute_instance.md) resource for the same instance, as both would fight over the attachments.
+`
+
+var _ resource.ResourceWithImportState = (*ResourceAttachment)(nil)
+
+type ResourceAttachment struct {
+	client *exoscale.Client
+}
+
+func NewResourceAttachment() resource.Resource {
+	return &ResourceAttachment{}
+}
+
+type ResourceAttachmentModel struct {
+	ID         types.String `tfsdk:"id"`
+	Zone       types.String `tfsdk:"zone"`
+	NetworkID  types.String `tfsdk:"network_id"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	IPAddress  types.String `tfsdk:"ip_address"`
+	MACAddress types.String `tfsdk:"mac_address"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceAttachment) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_network_attachment"
+}
+
+func (r *ResourceAttachment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Attach an Exoscale Compute Instance to a Private Network.",
+		MarkdownDescription: markdownDescriptionResourceAttachment,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource (`<network-ID>/<instance-ID>`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "❗ The Exoscale zone name.",
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description:         "❗ The ID of the private network to attach the instance to.",
+				MarkdownDescription: "❗ The [exoscale_private_network](./private_network.md) (ID) to attach the instance to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description:         "❗ The ID of the compute instance to attach.",
+				MarkdownDescription: "❗ The [exoscale_compute_instance](./compute_instance.md) (ID) to attach.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				Description:         "The IPv4 address to request as static DHCP lease if the network is a *managed* private network. It can be changed without detaching the instance.",
+				MarkdownDescription: "The IPv4 address to request as static DHCP lease if the network is a *managed* private network. It can be changed without detaching the instance.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{validators.IsIPAddress()},
+			},
+			"mac_address": schema.StringAttribute{
+				Description:         "The MAC address of the instance network interface.",
+				MarkdownDescription: "The MAC address of the instance network interface.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceAttachment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *ResourceAttachment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	networkID, instanceID, err := plan.parseIDs()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	request := exoscale.AttachInstanceToPrivateNetworkRequest{
+		Instance: &exoscale.AttachInstanceToPrivateNetworkRequestInstance{ID: instanceID},
+	}
+	if !plan.IPAddress.IsUnknown() && !plan.IPAddress.IsNull() {
+		request.IP = net.ParseIP(plan.IPAddress.ValueString())
+	}
+
+	op, err := client.AttachInstanceToPrivateNetwork(ctx, networkID, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API returned an error when attaching instance to private network",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+		resp.Diagnostics.AddError(
+			"attach instance to private network operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", networkID, instanceID))
+
+	found, err := plan.read(ctx, client, networkID, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API returned an error while reading private network attachment",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"private network attachment not found",
+			fmt.Sprintf("instance %s is not attached to private network %s", instanceID, networkID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceAttachment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	networkID, instanceID, err := state.parseIDs()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	found, err := state.read(ctx, client, networkID, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API returned an error while reading private network attachment",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		tflog.Info(
+			ctx,
+			"private network attachment not found, deleting from state to report drift",
+			map[string]any{},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceAttachment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ResourceAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	networkID, instanceID, err := plan.parseIDs()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.IPAddress.IsUnknown() && !plan.IPAddress.IsNull() && !plan.IPAddress.Equal(state.IPAddress) {
+		op, err := client.UpdatePrivateNetworkInstanceIP(ctx, networkID, exoscale.UpdatePrivateNetworkInstanceIPRequest{
+			Instance: &exoscale.UpdatePrivateNetworkInstanceIPRequestInstance{ID: instanceID},
+			IP:       net.ParseIP(plan.IPAddress.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API returned an error when updating private network instance IP",
+				err.Error(),
+			)
+			return
+		}
+
+		if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+			resp.Diagnostics.AddError(
+				"update private network instance IP operation failed",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	found, err := plan.read(ctx, client, networkID, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API returned an error while reading private network attachment",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"private network attachment not found",
+			fmt.Sprintf("instance %s is not attached to private network %s", instanceID, networkID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceAttachment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	networkID, instanceID, err := state.parseIDs()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err := client.DetachInstanceFromPrivateNetwork(ctx, networkID, exoscale.DetachInstanceFromPrivateNetworkRequest{
+		Instance: &exoscale.Instance{ID: instanceID},
+	})
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"API returned an error when detaching instance from private network",
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+		resp.Diagnostics.AddError(
+			"detach instance from private network operation failed",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ResourceAttachment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+	if len(idParts) != 2 || !slices.Contains(config.Zones, idParts[1]) {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: network_id/instance_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	ids := strings.Split(idParts[0], "/")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: network_id/instance_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var t timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceAttachmentModel{
+		ID:         types.StringValue(idParts[0]),
+		Zone:       types.StringValue(idParts[1]),
+		NetworkID:  types.StringValue(ids[0]),
+		InstanceID: types.StringValue(ids[1]),
+		IPAddress:  types.StringNull(),
+		MACAddress: types.StringNull(),
+		Timeouts:   t,
+	})...)
+}
+
+func (m *ResourceAttachmentModel) parseIDs() (exoscale.UUID, exoscale.UUID, error) {
+	networkID, err := exoscale.ParseUUID(m.NetworkID.ValueString())
+	if err != nil {
+		return "", "", fmt.Errorf("invalid private network ID: %w", err)
+	}
+
+	instanceID, err := exoscale.ParseUUID(m.InstanceID.ValueString())
+	if err != nil {
+		return "", "", fmt.Errorf("invalid instance ID: %w", err)
+	}
+
+	return networkID, instanceID, nil
+}
+
+// read refreshes the model from the API, it reports whether the attachment exists.
+func (m *ResourceAttachmentModel) read(
+	ctx context.Context,
+	client *exoscale.Client,
+	networkID exoscale.UUID,
+	instanceID exoscale.UUID,
+) (bool, error) {
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	idx := slices.IndexFunc(instance.PrivateNetworks, func(pn exoscale.InstancePrivateNetworks) bool {
+		return pn.ID == networkID
+	})
+	if idx == -1 {
+		return false, nil
+	}
+	m.MACAddress = optionalStringValue(instance.PrivateNetworks[idx].MACAddress)
+
+	privateNetwork, err := client.GetPrivateNetwork(ctx, networkID)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	m.IPAddress = types.StringNull()
+	for _, lease := range privateNetwork.Leases {
+		if lease.InstanceID == instanceID {
+			m.IPAddress = ipStringValue(lease.IP)
+			break
+		}
+	}
+
+	return true, nil
+}
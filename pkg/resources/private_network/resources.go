@@ -15,10 +15,14 @@ import (
 	"github.com/exoscale/terraform-provider-exoscale/pkg/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -103,8 +107,77 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				MarkdownDescription: "(For managed Privnets) The network mask defining the IPv4 network allowed for static leases.",
 				Validators:          []validator.String{validators.IsNetmask()},
 			},
+			"vni": schema.Int64Attribute{
+				Description:         "The private network VXLAN ID.",
+				MarkdownDescription: "The private network VXLAN ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"leases": schema.ListNestedAttribute{
+				Description:         "(For managed Privnets) The IPv4 addresses leased to compute instances.",
+				MarkdownDescription: "(For managed Privnets) The IPv4 addresses leased to compute instances.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Description:         "The compute instance ID.",
+							MarkdownDescription: "The [exoscale_compute_instance](./compute_instance.md) ID.",
+							Computed:            true,
+						},
+						"ip": schema.StringAttribute{
+							Description:         "The IPv4 address leased to the instance.",
+							MarkdownDescription: "The IPv4 address leased to the instance.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"options": schema.SingleNestedBlock{
+				Description:         "(For managed Privnets) The DHCP options advertised to compute instances.",
+				MarkdownDescription: "(For managed Privnets) The DHCP options advertised to compute instances.",
+				Attributes: map[string]schema.Attribute{
+					"dns_servers": schema.ListAttribute{
+						Description:         "A list of DNS servers IP addresses.",
+						MarkdownDescription: "A list of DNS servers IP addresses.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(validators.IsIPAddress()),
+						},
+					},
+					"ntp_servers": schema.ListAttribute{
+						Description:         "A list of NTP servers IP addresses.",
+						MarkdownDescription: "A list of NTP servers IP addresses.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(validators.IsIPAddress()),
+						},
+					},
+					"routers": schema.ListAttribute{
+						Description:         "A list of routers IP addresses (default gateways).",
+						MarkdownDescription: "A list of routers IP addresses (default gateways).",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(validators.IsIPAddress()),
+						},
+					},
+					"domain_search": schema.ListAttribute{
+						Description:         "A list of domains to use as DNS search suffixes (limited to 255 octets).",
+						MarkdownDescription: "A list of domains to use as DNS search suffixes (limited to 255 octets).",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
 			"timeouts": timeouts.BlockAll(ctx),
 		},
 	}
@@ -119,10 +192,23 @@ type ResourceModel struct {
 	StartIP     types.String `tfsdk:"start_ip"`
 	EndIP       types.String `tfsdk:"end_ip"`
 	Netmask     types.String `tfsdk:"netmask"`
+	Options     types.Object `tfsdk:"options"`
+	Vni         types.Int64  `tfsdk:"vni"`
+	Leases      types.List   `tfsdk:"leases"`
 
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
+// applyComputed sets the attributes computed by the API.
+func (m *ResourceModel) applyComputed(ctx context.Context, privateNetwork *exoscale.PrivateNetwork) diag.Diagnostics {
+	m.Vni = types.Int64Value(privateNetwork.Vni)
+
+	leases, diags := leasesToModel(ctx, privateNetwork.Leases)
+	m.Leases = leases
+
+	return diags
+}
+
 func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -166,6 +252,14 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		StartIP:     net.ParseIP(plan.StartIP.ValueString()),
 		Netmask:     net.ParseIP(plan.Netmask.ValueString()),
 	}
+
+	options, dg := optionsFromModel(ctx, plan.Options)
+	if dg.HasError() {
+		resp.Diagnostics.Append(dg...)
+		return
+	}
+	request.Options = options
+
 	if len(plan.Labels.Elements()) > 0 {
 		labels := exoscale.Labels{}
 
@@ -196,6 +290,21 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	plan.ID = types.StringValue(operation.Reference.ID.String())
+
+	privateNetwork, err := client.GetPrivateNetwork(ctx, operation.Reference.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API returned an error while fetching private network",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.applyComputed(ctx, privateNetwork)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -270,6 +379,12 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
+	options, dg := optionsToModel(ctx, privateNetwork.Options, !state.Options.IsNull())
+	if dg.HasError() {
+		resp.Diagnostics.Append(dg...)
+		return
+	}
+
 	state = ResourceModel{
 		ID:          types.StringValue(privateNetwork.ID.String()),
 		Name:        types.StringValue(privateNetwork.Name),
@@ -278,8 +393,14 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		StartIP:     ipStringValue(privateNetwork.StartIP),
 		EndIP:       ipStringValue(privateNetwork.EndIP),
 		Netmask:     ipStringValue(privateNetwork.Netmask),
+		Options:     options,
 		Timeouts:    state.Timeouts,
 	}
+	resp.Diagnostics.Append(state.applyComputed(ctx, privateNetwork)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	state.Labels = types.MapNull(types.StringType)
 	if privateNetwork.Labels != nil {
 		labels, dg := types.MapValueFrom(
@@ -299,9 +420,10 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan ResourceModel
+	var plan, state ResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -354,6 +476,22 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		StartIP:     net.ParseIP(plan.StartIP.ValueString()),
 		Netmask:     net.ParseIP(plan.Netmask.ValueString()),
 	}
+
+	options, dg := optionsFromModel(ctx, plan.Options)
+	if dg.HasError() {
+		resp.Diagnostics.Append(dg...)
+		return
+	}
+	updateClient := client
+	if options != nil || !state.Options.IsNull() {
+		// The request omits empty lists: send them explicitly to clear
+		// the DHCP options removed from the configuration.
+		request.Options = options
+		updateClient = utils.WithJSONBodyFields(client, map[string]any{
+			"options": newOptionsBody(options),
+		})
+	}
+
 	if len(plan.Labels.Elements()) > 0 {
 		labels := exoscale.Labels{}
 
@@ -366,7 +504,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		request.Labels = labels
 	}
 
-	operation, err := client.UpdatePrivateNetwork(ctx, id, request)
+	operation, err := updateClient.UpdatePrivateNetwork(ctx, id, request)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"API returned an error when updating private network",
@@ -383,6 +521,20 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	privateNetwork, err := client.GetPrivateNetwork(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API returned an error while fetching private network",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.applyComputed(ctx, privateNetwork)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -534,6 +686,8 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 		ID:       types.StringValue(id.String()),
 		Zone:     types.StringValue(zone),
 		Labels:   types.MapNull(types.StringType),
+		Options:  types.ObjectNull(OptionsModel{}.Types()),
+		Leases:   types.ListNull(types.ObjectType{AttrTypes: LeaseModel{}.Types()}),
 		Timeouts: t,
 	})...)
 }
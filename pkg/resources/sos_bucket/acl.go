@@ -0,0 +1,86 @@
+package sos_bucket
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const (
+	granteeAllUsers           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	granteeAuthenticatedUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+// cannedACLs lists the canned ACLs supported on buckets.
+var cannedACLs = []string{
+	string(types.BucketCannedACLPrivate),
+	string(types.BucketCannedACLPublicRead),
+	string(types.BucketCannedACLPublicReadWrite),
+	string(types.BucketCannedACLAuthenticatedRead),
+}
+
+// cannedACLFromGrants returns the canned ACL matching the bucket grants,
+// or false if the grants do not match any canned ACL (e.g. they were set
+// using explicit grants rather than a canned ACL).
+func cannedACLFromGrants(grants []types.Grant) (string, bool) {
+	groups := map[string][]types.Permission{}
+
+	for _, grant := range grants {
+		if grant.Grantee == nil {
+			continue
+		}
+
+		switch grant.Grantee.Type {
+		case types.TypeCanonicalUser:
+			// The owner grant is part of every canned ACL.
+			if grant.Permission != types.PermissionFullControl {
+				return "", false
+			}
+		case types.TypeGroup:
+			if grant.Grantee.URI == nil {
+				return "", false
+			}
+			groups[*grant.Grantee.URI] = append(groups[*grant.Grantee.URI], grant.Permission)
+		default:
+			return "", false
+		}
+	}
+
+	allUsers, authenticatedUsers := groups[granteeAllUsers], groups[granteeAuthenticatedUsers]
+	if len(groups) > 1 || (len(groups) == 1 && allUsers == nil && authenticatedUsers == nil) {
+		return "", false
+	}
+
+	switch {
+	case len(groups) == 0:
+		return string(types.BucketCannedACLPrivate), true
+	case hasPermissions(allUsers, types.PermissionRead):
+		return string(types.BucketCannedACLPublicRead), true
+	case hasPermissions(allUsers, types.PermissionRead, types.PermissionWrite):
+		return string(types.BucketCannedACLPublicReadWrite), true
+	case hasPermissions(authenticatedUsers, types.PermissionRead):
+		return string(types.BucketCannedACLAuthenticatedRead), true
+	}
+
+	return "", false
+}
+
+// hasPermissions reports whether actual holds exactly the expected permissions.
+func hasPermissions(actual []types.Permission, expected ...types.Permission) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+
+	for _, e := range expected {
+		found := false
+		for _, a := range actual {
+			if a == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
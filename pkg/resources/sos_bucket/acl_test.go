@@ -0,0 +1,88 @@
+package sos_bucket
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func TestCannedACLFromGrants(t *testing.T) {
+	owner := types.Grant{
+		Grantee:    &types.Grantee{Type: types.TypeCanonicalUser, ID: aws.String("owner")},
+		Permission: types.PermissionFullControl,
+	}
+	group := func(uri string, permission types.Permission) types.Grant {
+		return types.Grant{
+			Grantee:    &types.Grantee{Type: types.TypeGroup, URI: aws.String(uri)},
+			Permission: permission,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		grants   []types.Grant
+		expected string
+		ok       bool
+	}{
+		{
+			name:     "private",
+			grants:   []types.Grant{owner},
+			expected: "private",
+			ok:       true,
+		},
+		{
+			name:     "public-read",
+			grants:   []types.Grant{owner, group(granteeAllUsers, types.PermissionRead)},
+			expected: "public-read",
+			ok:       true,
+		},
+		{
+			name: "public-read-write",
+			grants: []types.Grant{
+				owner,
+				group(granteeAllUsers, types.PermissionWrite),
+				group(granteeAllUsers, types.PermissionRead),
+			},
+			expected: "public-read-write",
+			ok:       true,
+		},
+		{
+			name:     "authenticated-read",
+			grants:   []types.Grant{owner, group(granteeAuthenticatedUsers, types.PermissionRead)},
+			expected: "authenticated-read",
+			ok:       true,
+		},
+		{
+			name:   "write only",
+			grants: []types.Grant{owner, group(granteeAllUsers, types.PermissionWrite)},
+		},
+		{
+			name: "mixed groups",
+			grants: []types.Grant{
+				owner,
+				group(granteeAllUsers, types.PermissionRead),
+				group(granteeAuthenticatedUsers, types.PermissionRead),
+			},
+		},
+		{
+			name: "explicit user grant",
+			grants: []types.Grant{
+				owner,
+				{
+					Grantee:    &types.Grantee{Type: types.TypeCanonicalUser, ID: aws.String("other")},
+					Permission: types.PermissionRead,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, ok := cannedACLFromGrants(tt.grants)
+			if ok != tt.ok || actual != tt.expected {
+				t.Errorf("expected (%q, %t), got (%q, %t)", tt.expected, tt.ok, actual, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package sos_bucket
+
+const (
+	AttrACL                     = "acl"
+	attrACLDescription          = "The [canned ACL](https://community.exoscale.com/product/storage/object-storage/how-to/acl/) applied to the bucket (`private`, `public-read`, `public-read-write` or `authenticated-read`)."
+	AttrBucket                  = "bucket"
+	attrBucketDescription       = "The name of the bucket."
+	AttrForceDestroy            = "force_destroy"
+	attrForceDestroyDescription = "Delete all the objects (including all object versions) of the bucket on destroy, so that the bucket can be destroyed without error (boolean; default: `false`)."
+	AttrURL                     = "url"
+	attrURLDescription          = "The bucket URL."
+	AttrZone                    = "zone"
+	attrZoneDescription         = "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name."
+)
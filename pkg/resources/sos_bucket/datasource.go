@@ -0,0 +1,161 @@
+package sos_bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+)
+
+const DataSourceSOSBucketDescription = `Fetch Exoscale [SOS Buckets](https://community.exoscale.com/product/storage/object-storage/).
+
+Corresponding resource: [exoscale_sos_bucket](../resources/sos_bucket.md).`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &DataSourceSOSBucket{}
+
+// DataSourceSOSBucket defines the data source implementation.
+type DataSourceSOSBucket struct {
+	baseConfig *providerConfig.BaseConfig
+}
+
+// NewDataSourceSOSBucket creates instance of DataSourceSOSBucket.
+func NewDataSourceSOSBucket() datasource.DataSource {
+	return &DataSourceSOSBucket{}
+}
+
+// DataSourceSOSBucketModel defines the data source data model.
+type DataSourceSOSBucketModel struct {
+	Bucket types.String `tfsdk:"bucket"`
+	ACL    types.String `tfsdk:"acl"`
+	URL    types.String `tfsdk:"url"`
+	Zone   types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies data source name.
+func (d *DataSourceSOSBucket) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sos_bucket"
+}
+
+// Schema defines data source attributes.
+func (d *DataSourceSOSBucket) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceSOSBucketDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrBucket: schema.StringAttribute{
+				MarkdownDescription: attrBucketDescription,
+				Required:            true,
+			},
+			AttrACL: schema.StringAttribute{
+				MarkdownDescription: attrACLDescription + " Empty if the bucket grants do not match any canned ACL.",
+				Computed:            true,
+			},
+			AttrURL: schema.StringAttribute{
+				MarkdownDescription: attrURLDescription,
+				Computed:            true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: attrZoneDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up datasource dependencies.
+func (d *DataSourceSOSBucket) Configure(
+	ctx context.Context,
+	r datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if r.ProviderData == nil {
+		return
+	}
+
+	d.baseConfig = &r.ProviderData.(*providerConfig.ExoscaleProviderConfig).Config
+}
+
+func (d *DataSourceSOSBucket) NewSOSClient(ctx context.Context, zone string) (*s3.Client, error) {
+	return sos.NewSOSClient(ctx, zone, d.baseConfig.SOSEndpoint, d.baseConfig.Key, d.baseConfig.Secret)
+}
+
+// Read defines how the data source updates Terraform's state to reflect the retrieved data.
+func (d *DataSourceSOSBucket) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan DataSourceSOSBucketModel
+
+	// Load Terraform plan into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := d.NewSOSClient(ctx, plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	// Read remote state.
+	acl, err := sosClient.GetBucketAcl(ctx, &s3.GetBucketAclInput{
+		Bucket: plan.Bucket.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to get bucket ACL",
+			err.Error(),
+		)
+		return
+	}
+
+	cannedACL, _ := cannedACLFromGrants(acl.Grants)
+	plan.ACL = types.StringValue(cannedACL)
+	plan.URL = types.StringValue(bucketURL(plan.Zone.ValueString(), d.baseConfig.SOSEndpoint, plan.Bucket.ValueString()))
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "datasource read done", map[string]any{
+		AttrBucket: plan.Bucket,
+	})
+}
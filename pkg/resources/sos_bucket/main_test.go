@@ -0,0 +1,181 @@
+package sos_bucket_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func TestSOSBucket(t *testing.T) {
+	t.Parallel()
+
+	bucketResourceName := "exoscale_sos_bucket.test_bucket"
+	bucketDataSourceName := "data." + bucketResourceName
+	versioningResourceName := "exoscale_sos_bucket_versioning.test_versioning"
+	lifecycleResourceName := "exoscale_sos_bucket_lifecycle_configuration.test_lifecycle"
+	corsResourceName := "exoscale_sos_bucket_cors_configuration.test_cors"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+	bucketName := fmt.Sprintf("terraform-provider-test-%d", testdataSpec.ID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSOSBucketDestroy(testdataSpec.Zone, bucketName),
+		Steps: []resource.TestStep{
+			// 1 Create bucket
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.bucket_create.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(bucketResourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(bucketResourceName, "acl", "private"),
+					resource.TestCheckResourceAttr(bucketResourceName, "force_destroy", "true"),
+					resource.TestCheckResourceAttr(
+						bucketResourceName,
+						"url",
+						fmt.Sprintf("https://sos-%s.exo.io/%s", testdataSpec.Zone, bucketName),
+					),
+					resource.TestCheckResourceAttr(bucketDataSourceName, "acl", "private"),
+					resource.TestCheckResourceAttrPair(bucketDataSourceName, "url", bucketResourceName, "url"),
+					// Ensure force_destroy empties the bucket on destroy.
+					testAccPutSOSObject(testdataSpec.Zone, bucketName, "force-destroy"),
+				),
+			},
+			// 2 Update ACL
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.bucket_update.tf.tmpl", &testdataSpec),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(bucketResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(bucketResourceName, "acl", "public-read"),
+					resource.TestCheckResourceAttr(bucketDataSourceName, "acl", "public-read"),
+				),
+			},
+			// 3 Configure bucket versioning, lifecycle and CORS
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/003.bucket_configuration.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(versioningResourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttr(lifecycleResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(lifecycleResourceName, "rule.0.prefix", "tmp/"),
+					resource.TestCheckResourceAttr(lifecycleResourceName, "rule.0.expiration_days", "1"),
+					resource.TestCheckResourceAttr(lifecycleResourceName, "rule.1.noncurrent_version_expiration_days", "30"),
+					resource.TestCheckResourceAttr(corsResourceName, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(corsResourceName, "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(corsResourceName, "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			// Import
+			{
+				ResourceName: bucketResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", s.RootModule().Resources[bucketResourceName].Primary.Attributes["bucket"], testdataSpec.Zone), nil
+					}
+				}(),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+				ImportStateVerifyIgnore:              []string{"force_destroy", "timeouts"},
+			},
+			{
+				ResourceName: versioningResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", bucketName, testdataSpec.Zone), nil
+					}
+				}(),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			{
+				ResourceName: lifecycleResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", bucketName, testdataSpec.Zone), nil
+					}
+				}(),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			{
+				ResourceName: corsResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", bucketName, testdataSpec.Zone), nil
+					}
+				}(),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func newSOSClient(ctx context.Context, zone string) (*s3.Client, error) {
+	return sos.NewSOSClient(ctx, zone, "", os.Getenv("EXOSCALE_API_KEY"), os.Getenv("EXOSCALE_API_SECRET"))
+}
+
+func testAccPutSOSObject(zone, bucket, key string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		ctx := context.Background()
+		client, err := newSOSClient(ctx, zone)
+		if err != nil {
+			return fmt.Errorf("unable to initialize SOS client: %w", err)
+		}
+
+		_, err = client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket: &bucket,
+			Key:    &key,
+			Body:   strings.NewReader(key),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckSOSBucketDestroy(zone, bucket string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		ctx := context.Background()
+		client, err := newSOSClient(ctx, zone)
+		if err != nil {
+			return fmt.Errorf("unable to initialize SOS client: %w", err)
+		}
+
+		_, err = client.HeadBucket(ctx, &s3.HeadBucketInput{
+			Bucket: &bucket,
+		})
+		if err != nil {
+			if sos.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		return errors.New("SOS bucket still exists")
+	}
+}
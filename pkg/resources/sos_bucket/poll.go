@@ -0,0 +1,28 @@
+package sos_bucket
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// pollBucketConfiguration calls check until it succeeds.
+// Unfortunately the bucket configuration calls may return before the
+// configuration is available through the matching get call.
+func pollBucketConfiguration(ctx context.Context, check func(context.Context) error) error {
+	var err error
+
+	for range 10 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		if err = check(ctx); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("timed out waiting for bucket configuration to be available: %w", err)
+}
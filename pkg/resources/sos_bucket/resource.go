@@ -0,0 +1,460 @@
+package sos_bucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+)
+
+const ResourceSOSBucketDescription = `Manage Exoscale [SOS Buckets](https://community.exoscale.com/product/storage/object-storage/).
+
+Corresponding data source: [exoscale_sos_bucket](../data-sources/sos_bucket.md).
+`
+
+// deleteObjectsBatchSize is the maximum number of objects a DeleteObjects call accepts.
+const deleteObjectsBatchSize = 1000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSOSBucket{}
+var _ resource.ResourceWithImportState = &ResourceSOSBucket{}
+
+// ResourceSOSBucket defines the resource implementation.
+type ResourceSOSBucket struct {
+	baseConfig *providerConfig.BaseConfig
+}
+
+// NewResourceSOSBucket creates instance of ResourceSOSBucket.
+func NewResourceSOSBucket() resource.Resource {
+	return &ResourceSOSBucket{}
+}
+
+// ResourceSOSBucketModel defines the resource data model.
+type ResourceSOSBucketModel struct {
+	Bucket       types.String `tfsdk:"bucket"`
+	ACL          types.String `tfsdk:"acl"`
+	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+	URL          types.String `tfsdk:"url"`
+	Zone         types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSOSBucket) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sos_bucket"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceSOSBucket) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceSOSBucketDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrBucket: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrBucketDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+				},
+			},
+			AttrACL: schema.StringAttribute{
+				MarkdownDescription: attrACLDescription + " Defaults to `private`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(s3types.BucketCannedACLPrivate)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(cannedACLs...),
+				},
+			},
+			AttrForceDestroy: schema.BoolAttribute{
+				MarkdownDescription: attrForceDestroyDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			AttrURL: schema.StringAttribute{
+				MarkdownDescription: attrURLDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceSOSBucket) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.baseConfig = &req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Config
+}
+
+func (r *ResourceSOSBucket) NewSOSClient(ctx context.Context, zone string) (*s3.Client, error) {
+	return sos.NewSOSClient(ctx, zone, r.baseConfig.SOSEndpoint, r.baseConfig.Key, r.baseConfig.Secret)
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceSOSBucket) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSOSBucketModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = sosClient.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: plan.Bucket.ValueStringPointer(),
+		ACL:    s3types.BucketCannedACL(plan.ACL.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create bucket",
+			err.Error(),
+		)
+		return
+	}
+
+	// The bucket may not be available right after its creation.
+	err = s3.NewBucketExistsWaiter(sosClient).Wait(ctx, &s3.HeadBucketInput{
+		Bucket: plan.Bucket.ValueStringPointer(),
+	}, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to wait for bucket",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.URL = types.StringValue(bucketURL(plan.Zone.ValueString(), r.baseConfig.SOSEndpoint, plan.Bucket.ValueString()))
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]any{
+		AttrBucket: plan.Bucket,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceSOSBucket) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSOSBucketModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	acl, err := sosClient.GetBucketAcl(ctx, &s3.GetBucketAclInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+	})
+	if err != nil {
+		if sos.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to get bucket ACL",
+			err.Error(),
+		)
+		return
+	}
+
+	// Grants not matching a canned ACL are left untouched (e.g. set outside of Terraform).
+	if cannedACL, ok := cannedACLFromGrants(acl.Grants); ok {
+		state.ACL = types.StringValue(cannedACL)
+	} else {
+		tflog.Warn(ctx, "bucket grants do not match any canned ACL", map[string]any{
+			AttrBucket: state.Bucket,
+		})
+	}
+
+	// Imported resources.
+	if state.ForceDestroy.IsNull() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
+
+	state.URL = types.StringValue(bucketURL(state.Zone.ValueString(), r.baseConfig.SOSEndpoint, state.Bucket.ValueString()))
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceSOSBucket) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceSOSBucketModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.ACL.Equal(state.ACL) {
+		_, err = sosClient.PutBucketAcl(ctx, &s3.PutBucketAclInput{
+			Bucket: plan.Bucket.ValueStringPointer(),
+			ACL:    s3types.BucketCannedACL(plan.ACL.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to put bucket ACL",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]any{
+		AttrBucket: plan.Bucket,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceSOSBucket) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSOSBucketModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	if state.ForceDestroy.ValueBool() {
+		if err := emptyBucket(ctx, sosClient, state.Bucket.ValueString()); err != nil {
+			if sos.IsNotFound(err) {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"failed to empty bucket",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	_, err = sosClient.DeleteBucket(ctx, &s3.DeleteBucketInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+	})
+	if err != nil {
+		if sos.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to delete bucket",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceSOSBucket) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: bucket@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceSOSBucketModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.Bucket = types.StringValue(idParts[0])
+	state.Zone = types.StringValue(idParts[1])
+	state.ForceDestroy = types.BoolValue(false)
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// emptyBucket deletes all the objects of a bucket, including all object versions and delete markers.
+func emptyBucket(ctx context.Context, sosClient *s3.Client, bucket string) error {
+	paginator := s3.NewListObjectVersionsPaginator(sosClient, &s3.ListObjectVersionsInput{
+		Bucket: &bucket,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		objects := make([]s3types.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+		for _, version := range page.Versions {
+			objects = append(objects, s3types.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range page.DeleteMarkers {
+			objects = append(objects, s3types.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+
+		for start := 0; start < len(objects); start += deleteObjectsBatchSize {
+			end := min(start+deleteObjectsBatchSize, len(objects))
+
+			out, err := sosClient.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+				Bucket: &bucket,
+				Delete: &s3types.Delete{
+					Objects: objects[start:end],
+					Quiet:   aws.Bool(true),
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(out.Errors) > 0 {
+				e := out.Errors[0]
+				return fmt.Errorf(
+					"unable to delete %d object(s), first error on %q: %s",
+					len(out.Errors),
+					aws.ToString(e.Key),
+					aws.ToString(e.Message),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// bucketURL returns the path-style URL of a bucket.
+func bucketURL(zone, sosEndpoint, bucket string) string {
+	return strings.TrimSuffix(sos.Endpoint(zone, sosEndpoint), "/") + "/" + bucket
+}
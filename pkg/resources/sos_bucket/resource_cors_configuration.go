@@ -0,0 +1,495 @@
+package sos_bucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+)
+
+const ResourceSOSBucketCORSConfigurationDescription = `Manage the [CORS](https://developer.mozilla.org/en-US/docs/Web/HTTP/CORS) configuration of Exoscale [SOS Buckets](https://community.exoscale.com/product/storage/object-storage/).
+
+There must be at most one such resource per bucket: the whole configuration is replaced on each update.
+`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSOSBucketCORSConfiguration{}
+var _ resource.ResourceWithImportState = &ResourceSOSBucketCORSConfiguration{}
+
+// ResourceSOSBucketCORSConfiguration defines the resource implementation.
+type ResourceSOSBucketCORSConfiguration struct {
+	baseConfig *providerConfig.BaseConfig
+}
+
+// NewResourceSOSBucketCORSConfiguration creates instance of ResourceSOSBucketCORSConfiguration.
+func NewResourceSOSBucketCORSConfiguration() resource.Resource {
+	return &ResourceSOSBucketCORSConfiguration{}
+}
+
+// ResourceSOSBucketCORSConfigurationModel defines the resource data model.
+type ResourceSOSBucketCORSConfigurationModel struct {
+	Bucket types.String    `tfsdk:"bucket"`
+	Rules  []CORSRuleModel `tfsdk:"cors_rule"`
+	Zone   types.String    `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// CORSRuleModel defines a CORS rule data model.
+type CORSRuleModel struct {
+	ID             types.String   `tfsdk:"id"`
+	AllowedHeaders []types.String `tfsdk:"allowed_headers"`
+	AllowedMethods []types.String `tfsdk:"allowed_methods"`
+	AllowedOrigins []types.String `tfsdk:"allowed_origins"`
+	ExposeHeaders  []types.String `tfsdk:"expose_headers"`
+	MaxAgeSeconds  types.Int64    `tfsdk:"max_age_seconds"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSOSBucketCORSConfiguration) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sos_bucket_cors_configuration"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceSOSBucketCORSConfiguration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceSOSBucketCORSConfigurationDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrBucket: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrBucketDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cors_rule": schema.ListNestedAttribute{
+				MarkdownDescription: "The CORS rules of the bucket.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The rule unique identifier.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"allowed_headers": schema.SetAttribute{
+							MarkdownDescription: "The headers allowed in preflight requests (`Access-Control-Request-Headers`).",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"allowed_methods": schema.SetAttribute{
+							MarkdownDescription: "The HTTP methods allowed (`GET`, `PUT`, `HEAD`, `POST` or `DELETE`).",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(
+									stringvalidator.OneOf("GET", "PUT", "HEAD", "POST", "DELETE"),
+								),
+							},
+						},
+						"allowed_origins": schema.SetAttribute{
+							MarkdownDescription: "The origins allowed to access the bucket.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"expose_headers": schema.SetAttribute{
+							MarkdownDescription: "The response headers client applications are allowed to access.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							MarkdownDescription: "The time in seconds browsers may cache the preflight response.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceSOSBucketCORSConfiguration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.baseConfig = &req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Config
+}
+
+func (r *ResourceSOSBucketCORSConfiguration) NewSOSClient(ctx context.Context, zone string) (*s3.Client, error) {
+	return sos.NewSOSClient(ctx, zone, r.baseConfig.SOSEndpoint, r.baseConfig.Key, r.baseConfig.Secret)
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceSOSBucketCORSConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSOSBucketCORSConfigurationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := putBucketCORS(ctx, sosClient, plan.Bucket.ValueString(), plan.Rules); err != nil {
+		resp.Diagnostics.AddError(
+			"failed to put bucket CORS configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]any{
+		AttrBucket: plan.Bucket,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceSOSBucketCORSConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSOSBucketCORSConfigurationModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	cors, err := sosClient.GetBucketCors(ctx, &s3.GetBucketCorsInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+	})
+	if err != nil {
+		if sos.IsNotFound(err) || sos.HasErrorCode(err, "NoSuchCORSConfiguration") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to get bucket CORS configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Rules = corsRulesFromAPI(cors.CORSRules)
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceSOSBucketCORSConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceSOSBucketCORSConfigurationModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := putBucketCORS(ctx, sosClient, plan.Bucket.ValueString(), plan.Rules); err != nil {
+		resp.Diagnostics.AddError(
+			"failed to put bucket CORS configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]any{
+		AttrBucket: plan.Bucket,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceSOSBucketCORSConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSOSBucketCORSConfigurationModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = sosClient.DeleteBucketCors(ctx, &s3.DeleteBucketCorsInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+	})
+	if err != nil {
+		if sos.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to delete bucket CORS configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceSOSBucketCORSConfiguration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: bucket@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceSOSBucketCORSConfigurationModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.Bucket = types.StringValue(idParts[0])
+	state.Zone = types.StringValue(idParts[1])
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// putBucketCORS replaces the bucket CORS configuration and waits until it is effective.
+func putBucketCORS(ctx context.Context, sosClient *s3.Client, bucket string, rules []CORSRuleModel) error {
+	_, err := sosClient.PutBucketCors(ctx, &s3.PutBucketCorsInput{
+		Bucket: &bucket,
+		CORSConfiguration: &s3types.CORSConfiguration{
+			CORSRules: corsRulesToAPI(rules),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return pollBucketConfiguration(ctx, func(ctx context.Context) error {
+		cors, err := sosClient.GetBucketCors(ctx, &s3.GetBucketCorsInput{
+			Bucket: &bucket,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(cors.CORSRules) != len(rules) {
+			return fmt.Errorf("expected %d CORS rules, got %d", len(rules), len(cors.CORSRules))
+		}
+
+		return nil
+	})
+}
+
+func corsRulesToAPI(rules []CORSRuleModel) []s3types.CORSRule {
+	apiRules := make([]s3types.CORSRule, 0, len(rules))
+
+	for _, rule := range rules {
+		apiRule := s3types.CORSRule{
+			ID:             rule.ID.ValueStringPointer(),
+			AllowedHeaders: stringsToAPI(rule.AllowedHeaders),
+			AllowedMethods: stringsToAPI(rule.AllowedMethods),
+			AllowedOrigins: stringsToAPI(rule.AllowedOrigins),
+			ExposeHeaders:  stringsToAPI(rule.ExposeHeaders),
+		}
+
+		if !rule.MaxAgeSeconds.IsNull() {
+			apiRule.MaxAgeSeconds = aws.Int32(int32(rule.MaxAgeSeconds.ValueInt64()))
+		}
+
+		apiRules = append(apiRules, apiRule)
+	}
+
+	return apiRules
+}
+
+func corsRulesFromAPI(apiRules []s3types.CORSRule) []CORSRuleModel {
+	rules := make([]CORSRuleModel, 0, len(apiRules))
+
+	for _, apiRule := range apiRules {
+		rule := CORSRuleModel{
+			ID:             types.StringPointerValue(apiRule.ID),
+			AllowedHeaders: stringsFromAPI(apiRule.AllowedHeaders),
+			AllowedMethods: stringsFromAPI(apiRule.AllowedMethods),
+			AllowedOrigins: stringsFromAPI(apiRule.AllowedOrigins),
+			ExposeHeaders:  stringsFromAPI(apiRule.ExposeHeaders),
+			MaxAgeSeconds:  types.Int64Null(),
+		}
+
+		if apiRule.MaxAgeSeconds != nil {
+			rule.MaxAgeSeconds = types.Int64Value(int64(*apiRule.MaxAgeSeconds))
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func stringsToAPI(values []types.String) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+
+	return result
+}
+
+// stringsFromAPI returns nil for empty values, so that optional sets remain null.
+func stringsFromAPI(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]types.String, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+
+	return result
+}
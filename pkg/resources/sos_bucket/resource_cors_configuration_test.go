@@ -0,0 +1,37 @@
+package sos_bucket
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCORSRulesRoundTrip(t *testing.T) {
+	rules := []CORSRuleModel{
+		{
+			ID:             types.StringValue("web"),
+			AllowedHeaders: []types.String{types.StringValue("*")},
+			AllowedMethods: []types.String{types.StringValue("GET"), types.StringValue("HEAD")},
+			AllowedOrigins: []types.String{types.StringValue("https://example.net")},
+			ExposeHeaders:  []types.String{types.StringValue("ETag")},
+			MaxAgeSeconds:  types.Int64Value(3000),
+		},
+		{
+			ID:             types.StringNull(),
+			AllowedMethods: []types.String{types.StringValue("PUT")},
+			AllowedOrigins: []types.String{types.StringValue("*")},
+			MaxAgeSeconds:  types.Int64Null(),
+		},
+	}
+
+	apiRules := corsRulesToAPI(rules)
+	if apiRules[1].AllowedHeaders != nil || apiRules[1].MaxAgeSeconds != nil {
+		t.Errorf("expected unset optional fields, got %+v", apiRules[1])
+	}
+
+	actual := corsRulesFromAPI(apiRules)
+	if !reflect.DeepEqual(actual, rules) {
+		t.Errorf("expected %+v, got %+v", rules, actual)
+	}
+}
@@ -0,0 +1,535 @@
+package sos_bucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+)
+
+const ResourceSOSBucketLifecycleConfigurationDescription = `Manage the lifecycle configuration of Exoscale [SOS Buckets](https://community.exoscale.com/product/storage/object-storage/).
+
+There must be at most one such resource per bucket: the whole configuration is replaced on each update.
+`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSOSBucketLifecycleConfiguration{}
+var _ resource.ResourceWithImportState = &ResourceSOSBucketLifecycleConfiguration{}
+
+// ResourceSOSBucketLifecycleConfiguration defines the resource implementation.
+type ResourceSOSBucketLifecycleConfiguration struct {
+	baseConfig *providerConfig.BaseConfig
+}
+
+// NewResourceSOSBucketLifecycleConfiguration creates instance of ResourceSOSBucketLifecycleConfiguration.
+func NewResourceSOSBucketLifecycleConfiguration() resource.Resource {
+	return &ResourceSOSBucketLifecycleConfiguration{}
+}
+
+// ResourceSOSBucketLifecycleConfigurationModel defines the resource data model.
+type ResourceSOSBucketLifecycleConfigurationModel struct {
+	Bucket types.String         `tfsdk:"bucket"`
+	Rules  []LifecycleRuleModel `tfsdk:"rule"`
+	Zone   types.String         `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// LifecycleRuleModel defines a lifecycle rule data model.
+type LifecycleRuleModel struct {
+	ID                                 types.String               `tfsdk:"id"`
+	Status                             types.String               `tfsdk:"status"`
+	Prefix                             types.String               `tfsdk:"prefix"`
+	ExpirationDays                     types.Int64                `tfsdk:"expiration_days"`
+	NoncurrentVersionExpirationDays    types.Int64                `tfsdk:"noncurrent_version_expiration_days"`
+	AbortIncompleteMultipartUploadDays types.Int64                `tfsdk:"abort_incomplete_multipart_upload_days"`
+	Transitions                        []LifecycleTransitionModel `tfsdk:"transition"`
+}
+
+// LifecycleTransitionModel defines a lifecycle rule transition data model.
+type LifecycleTransitionModel struct {
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSOSBucketLifecycleConfiguration) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sos_bucket_lifecycle_configuration"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceSOSBucketLifecycleConfiguration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceSOSBucketLifecycleConfigurationDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrBucket: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrBucketDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rule": schema.ListNestedAttribute{
+				MarkdownDescription: "The lifecycle rules of the bucket.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The rule unique identifier.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Whether the rule is applied (`Enabled` or `Disabled`).",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(s3types.ExpirationStatusEnabled),
+									string(s3types.ExpirationStatusDisabled),
+								),
+							},
+						},
+						"prefix": schema.StringAttribute{
+							MarkdownDescription: "Only apply the rule to objects with keys starting with this prefix (default: all objects).",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"expiration_days": schema.Int64Attribute{
+							MarkdownDescription: "Delete objects this number of days after their creation.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"noncurrent_version_expiration_days": schema.Int64Attribute{
+							MarkdownDescription: "Delete non-current object versions this number of days after they became non-current (versioned buckets only).",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"abort_incomplete_multipart_upload_days": schema.Int64Attribute{
+							MarkdownDescription: "Abort incomplete multipart uploads this number of days after their initiation.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"transition": schema.ListNestedAttribute{
+							MarkdownDescription: "Transition objects to another storage class.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.Int64Attribute{
+										MarkdownDescription: "Transition objects this number of days after their creation.",
+										Required:            true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"storage_class": schema.StringAttribute{
+										MarkdownDescription: "The storage class to transition objects to.",
+										Required:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceSOSBucketLifecycleConfiguration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.baseConfig = &req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Config
+}
+
+func (r *ResourceSOSBucketLifecycleConfiguration) NewSOSClient(ctx context.Context, zone string) (*s3.Client, error) {
+	return sos.NewSOSClient(ctx, zone, r.baseConfig.SOSEndpoint, r.baseConfig.Key, r.baseConfig.Secret)
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceSOSBucketLifecycleConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSOSBucketLifecycleConfigurationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := putBucketLifecycleConfiguration(ctx, sosClient, plan.Bucket.ValueString(), plan.Rules); err != nil {
+		resp.Diagnostics.AddError(
+			"failed to put bucket lifecycle configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]any{
+		AttrBucket: plan.Bucket,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceSOSBucketLifecycleConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSOSBucketLifecycleConfigurationModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	lifecycle, err := sosClient.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+	})
+	if err != nil {
+		if sos.IsNotFound(err) || sos.HasErrorCode(err, "NoSuchLifecycleConfiguration") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to get bucket lifecycle configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Rules = lifecycleRulesFromAPI(lifecycle.Rules)
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceSOSBucketLifecycleConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceSOSBucketLifecycleConfigurationModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := putBucketLifecycleConfiguration(ctx, sosClient, plan.Bucket.ValueString(), plan.Rules); err != nil {
+		resp.Diagnostics.AddError(
+			"failed to put bucket lifecycle configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]any{
+		AttrBucket: plan.Bucket,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceSOSBucketLifecycleConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSOSBucketLifecycleConfigurationModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = sosClient.DeleteBucketLifecycle(ctx, &s3.DeleteBucketLifecycleInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+	})
+	if err != nil {
+		if sos.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to delete bucket lifecycle configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceSOSBucketLifecycleConfiguration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: bucket@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceSOSBucketLifecycleConfigurationModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.Bucket = types.StringValue(idParts[0])
+	state.Zone = types.StringValue(idParts[1])
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// putBucketLifecycleConfiguration replaces the bucket lifecycle configuration and waits until it is effective.
+func putBucketLifecycleConfiguration(ctx context.Context, sosClient *s3.Client, bucket string, rules []LifecycleRuleModel) error {
+	_, err := sosClient.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: &bucket,
+		LifecycleConfiguration: &s3types.BucketLifecycleConfiguration{
+			Rules: lifecycleRulesToAPI(rules),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return pollBucketConfiguration(ctx, func(ctx context.Context) error {
+		lifecycle, err := sosClient.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+			Bucket: &bucket,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(lifecycle.Rules) != len(rules) {
+			return fmt.Errorf("expected %d lifecycle rules, got %d", len(rules), len(lifecycle.Rules))
+		}
+
+		return nil
+	})
+}
+
+func lifecycleRulesToAPI(rules []LifecycleRuleModel) []s3types.LifecycleRule {
+	apiRules := make([]s3types.LifecycleRule, 0, len(rules))
+
+	for _, rule := range rules {
+		apiRule := s3types.LifecycleRule{
+			ID:     rule.ID.ValueStringPointer(),
+			Status: s3types.ExpirationStatus(rule.Status.ValueString()),
+			// An empty prefix filter matches all the objects of the bucket.
+			Filter: &s3types.LifecycleRuleFilter{
+				Prefix: aws.String(rule.Prefix.ValueString()),
+			},
+		}
+
+		if !rule.ExpirationDays.IsNull() {
+			apiRule.Expiration = &s3types.LifecycleExpiration{
+				Days: aws.Int32(int32(rule.ExpirationDays.ValueInt64())),
+			}
+		}
+
+		if !rule.NoncurrentVersionExpirationDays.IsNull() {
+			apiRule.NoncurrentVersionExpiration = &s3types.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int32(int32(rule.NoncurrentVersionExpirationDays.ValueInt64())),
+			}
+		}
+
+		if !rule.AbortIncompleteMultipartUploadDays.IsNull() {
+			apiRule.AbortIncompleteMultipartUpload = &s3types.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int32(int32(rule.AbortIncompleteMultipartUploadDays.ValueInt64())),
+			}
+		}
+
+		for _, transition := range rule.Transitions {
+			apiRule.Transitions = append(apiRule.Transitions, s3types.Transition{
+				Days:         aws.Int32(int32(transition.Days.ValueInt64())),
+				StorageClass: s3types.TransitionStorageClass(transition.StorageClass.ValueString()),
+			})
+		}
+
+		apiRules = append(apiRules, apiRule)
+	}
+
+	return apiRules
+}
+
+func lifecycleRulesFromAPI(apiRules []s3types.LifecycleRule) []LifecycleRuleModel {
+	rules := make([]LifecycleRuleModel, 0, len(apiRules))
+
+	for _, apiRule := range apiRules {
+		rule := LifecycleRuleModel{
+			ID:                                 types.StringPointerValue(apiRule.ID),
+			Status:                             types.StringValue(string(apiRule.Status)),
+			Prefix:                             types.StringNull(),
+			ExpirationDays:                     types.Int64Null(),
+			NoncurrentVersionExpirationDays:    types.Int64Null(),
+			AbortIncompleteMultipartUploadDays: types.Int64Null(),
+		}
+
+		// Legacy rules may define the prefix outside of the filter.
+		prefix := apiRule.Prefix
+		if apiRule.Filter != nil && apiRule.Filter.Prefix != nil {
+			prefix = apiRule.Filter.Prefix
+		}
+		if aws.ToString(prefix) != "" {
+			rule.Prefix = types.StringPointerValue(prefix)
+		}
+
+		if apiRule.Expiration != nil && apiRule.Expiration.Days != nil {
+			rule.ExpirationDays = types.Int64Value(int64(*apiRule.Expiration.Days))
+		}
+
+		if apiRule.NoncurrentVersionExpiration != nil && apiRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
+			rule.NoncurrentVersionExpirationDays = types.Int64Value(int64(*apiRule.NoncurrentVersionExpiration.NoncurrentDays))
+		}
+
+		if apiRule.AbortIncompleteMultipartUpload != nil && apiRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+			rule.AbortIncompleteMultipartUploadDays = types.Int64Value(int64(*apiRule.AbortIncompleteMultipartUpload.DaysAfterInitiation))
+		}
+
+		for _, transition := range apiRule.Transitions {
+			rule.Transitions = append(rule.Transitions, LifecycleTransitionModel{
+				Days:         types.Int64Value(int64(aws.ToInt32(transition.Days))),
+				StorageClass: types.StringValue(string(transition.StorageClass)),
+			})
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
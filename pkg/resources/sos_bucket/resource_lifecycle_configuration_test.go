@@ -0,0 +1,67 @@
+package sos_bucket
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLifecycleRulesRoundTrip(t *testing.T) {
+	rules := []LifecycleRuleModel{
+		{
+			ID:                                 types.StringValue("expire-logs"),
+			Status:                             types.StringValue("Enabled"),
+			Prefix:                             types.StringValue("logs/"),
+			ExpirationDays:                     types.Int64Value(30),
+			NoncurrentVersionExpirationDays:    types.Int64Null(),
+			AbortIncompleteMultipartUploadDays: types.Int64Value(7),
+		},
+		{
+			ID:                                 types.StringValue("archive"),
+			Status:                             types.StringValue("Disabled"),
+			Prefix:                             types.StringNull(),
+			ExpirationDays:                     types.Int64Null(),
+			NoncurrentVersionExpirationDays:    types.Int64Value(90),
+			AbortIncompleteMultipartUploadDays: types.Int64Null(),
+			Transitions: []LifecycleTransitionModel{
+				{Days: types.Int64Value(10), StorageClass: types.StringValue("GLACIER")},
+			},
+		},
+	}
+
+	apiRules := lifecycleRulesToAPI(rules)
+	if len(apiRules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(apiRules))
+	}
+	if prefix := aws.ToString(apiRules[1].Filter.Prefix); prefix != "" {
+		t.Errorf("expected empty prefix filter for rule without prefix, got %q", prefix)
+	}
+	if apiRules[1].Expiration != nil {
+		t.Errorf("expected no expiration for rule without expiration_days")
+	}
+
+	actual := lifecycleRulesFromAPI(apiRules)
+	if !reflect.DeepEqual(actual, rules) {
+		t.Errorf("expected %+v, got %+v", rules, actual)
+	}
+}
+
+func TestLifecycleRulesFromAPILegacyPrefix(t *testing.T) {
+	rules := lifecycleRulesFromAPI([]s3types.LifecycleRule{
+		{
+			ID:     aws.String("legacy"),
+			Status: s3types.ExpirationStatusEnabled,
+			Prefix: aws.String("tmp/"),
+			Expiration: &s3types.LifecycleExpiration{
+				Days: aws.Int32(1),
+			},
+		},
+	})
+
+	if len(rules) != 1 || rules[0].Prefix.ValueString() != "tmp/" {
+		t.Errorf("expected legacy prefix %q, got %+v", "tmp/", rules)
+	}
+}
@@ -0,0 +1,371 @@
+package sos_bucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+)
+
+const ResourceSOSBucketVersioningDescription = `Manage the versioning of Exoscale [SOS Buckets](https://community.exoscale.com/product/storage/object-storage/).
+
+~> **NOTE:** once enabled, the versioning of a bucket cannot be disabled anymore, only suspended: destroying this resource suspends the bucket versioning.
+`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSOSBucketVersioning{}
+var _ resource.ResourceWithImportState = &ResourceSOSBucketVersioning{}
+
+// ResourceSOSBucketVersioning defines the resource implementation.
+type ResourceSOSBucketVersioning struct {
+	baseConfig *providerConfig.BaseConfig
+}
+
+// NewResourceSOSBucketVersioning creates instance of ResourceSOSBucketVersioning.
+func NewResourceSOSBucketVersioning() resource.Resource {
+	return &ResourceSOSBucketVersioning{}
+}
+
+// ResourceSOSBucketVersioningModel defines the resource data model.
+type ResourceSOSBucketVersioningModel struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Status types.String `tfsdk:"status"`
+	Zone   types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSOSBucketVersioning) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sos_bucket_versioning"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceSOSBucketVersioning) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceSOSBucketVersioningDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrBucket: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrBucketDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The versioning state of the bucket (`Enabled` or `Suspended`).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(s3types.BucketVersioningStatusEnabled),
+						string(s3types.BucketVersioningStatusSuspended),
+					),
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceSOSBucketVersioning) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.baseConfig = &req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Config
+}
+
+func (r *ResourceSOSBucketVersioning) NewSOSClient(ctx context.Context, zone string) (*s3.Client, error) {
+	return sos.NewSOSClient(ctx, zone, r.baseConfig.SOSEndpoint, r.baseConfig.Key, r.baseConfig.Secret)
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceSOSBucketVersioning) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSOSBucketVersioningModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := putBucketVersioning(ctx, sosClient, plan.Bucket.ValueString(), plan.Status.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"failed to put bucket versioning",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]any{
+		AttrBucket: plan.Bucket,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceSOSBucketVersioning) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSOSBucketVersioningModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	versioning, err := sosClient.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+	})
+	if err != nil {
+		if sos.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to get bucket versioning",
+			err.Error(),
+		)
+		return
+	}
+
+	// Versioning was never configured on this bucket.
+	if versioning.Status == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Status = types.StringValue(string(versioning.Status))
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceSOSBucketVersioning) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceSOSBucketVersioningModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.Status.Equal(state.Status) {
+		if err := putBucketVersioning(ctx, sosClient, plan.Bucket.ValueString(), plan.Status.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"failed to put bucket versioning",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]any{
+		AttrBucket: plan.Bucket,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceSOSBucketVersioning) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSOSBucketVersioningModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	// Versioning cannot be disabled once enabled, only suspended.
+	_, err = sosClient.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+		VersioningConfiguration: &s3types.VersioningConfiguration{
+			Status: s3types.BucketVersioningStatusSuspended,
+		},
+	})
+	if err != nil {
+		if sos.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to suspend bucket versioning",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceSOSBucketVersioning) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: bucket@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceSOSBucketVersioningModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.Bucket = types.StringValue(idParts[0])
+	state.Zone = types.StringValue(idParts[1])
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]any{
+		AttrBucket: state.Bucket,
+	})
+}
+
+// putBucketVersioning sets the bucket versioning status and waits until it is effective.
+func putBucketVersioning(ctx context.Context, sosClient *s3.Client, bucket, status string) error {
+	_, err := sosClient.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket: &bucket,
+		VersioningConfiguration: &s3types.VersioningConfiguration{
+			Status: s3types.BucketVersioningStatus(status),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return pollBucketConfiguration(ctx, func(ctx context.Context) error {
+		versioning, err := sosClient.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+			Bucket: &bucket,
+		})
+		if err != nil {
+			return err
+		}
+
+		if string(versioning.Status) != status {
+			return fmt.Errorf("expected versioning status %q, got %q", status, versioning.Status)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,18 @@
+package sos_object
+
+const (
+	AttrBucket                 = "bucket"
+	attrBucketDescription      = "The name of the bucket containing the object."
+	AttrContentType            = "content_type"
+	attrContentTypeDescription = "The object [MIME type](https://developer.mozilla.org/en-US/docs/Web/HTTP/Basics_of_HTTP/MIME_types)."
+	AttrETag                   = "etag"
+	attrETagDescription        = "The object ETag (MD5 checksum of the object content)."
+	AttrKey                    = "key"
+	attrKeyDescription         = "The object key (path in the bucket)."
+	AttrMetadata               = "metadata"
+	attrMetadataDescription    = "The object user-defined metadata (map of lower-case keys to values)."
+	AttrVersionID              = "version_id"
+	attrVersionIDDescription   = "The object version ID (versioned buckets only)."
+	AttrZone                   = "zone"
+	attrZoneDescription        = "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name."
+)
@@ -0,0 +1,246 @@
+package sos_object
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+)
+
+const DataSourceSOSObjectDescription = `Fetch Exoscale [SOS](https://community.exoscale.com/product/storage/object-storage/) Objects, including their content.
+
+The whole object is loaded into memory: this data source is meant for small objects such as scripts or configuration files.
+
+Corresponding resource: [exoscale_sos_object](../resources/sos_object.md).`
+
+// maxObjectBodySize is the maximum size of the objects this data source reads.
+const maxObjectBodySize = 10 * 1024 * 1024
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &DataSourceSOSObject{}
+
+// DataSourceSOSObject defines the data source implementation.
+type DataSourceSOSObject struct {
+	baseConfig *providerConfig.BaseConfig
+}
+
+// NewDataSourceSOSObject creates instance of DataSourceSOSObject.
+func NewDataSourceSOSObject() datasource.DataSource {
+	return &DataSourceSOSObject{}
+}
+
+// DataSourceSOSObjectModel defines the data source data model.
+type DataSourceSOSObjectModel struct {
+	Bucket        types.String `tfsdk:"bucket"`
+	Key           types.String `tfsdk:"key"`
+	Body          types.String `tfsdk:"body"`
+	BodyBase64    types.String `tfsdk:"body_base64"`
+	ContentLength types.Int64  `tfsdk:"content_length"`
+	ContentType   types.String `tfsdk:"content_type"`
+	ETag          types.String `tfsdk:"etag"`
+	LastModified  types.String `tfsdk:"last_modified"`
+	Metadata      types.Map    `tfsdk:"metadata"`
+	VersionID     types.String `tfsdk:"version_id"`
+	Zone          types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies data source name.
+func (d *DataSourceSOSObject) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sos_object"
+}
+
+// Schema defines data source attributes.
+func (d *DataSourceSOSObject) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceSOSObjectDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrBucket: schema.StringAttribute{
+				MarkdownDescription: attrBucketDescription,
+				Required:            true,
+			},
+			AttrKey: schema.StringAttribute{
+				MarkdownDescription: attrKeyDescription,
+				Required:            true,
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "The object content, if it is a valid UTF-8 string (use `body_base64` otherwise).",
+				Computed:            true,
+			},
+			"body_base64": schema.StringAttribute{
+				MarkdownDescription: "The object content, base64-encoded.",
+				Computed:            true,
+			},
+			"content_length": schema.Int64Attribute{
+				MarkdownDescription: "The object size (bytes).",
+				Computed:            true,
+			},
+			AttrContentType: schema.StringAttribute{
+				MarkdownDescription: attrContentTypeDescription,
+				Computed:            true,
+			},
+			AttrETag: schema.StringAttribute{
+				MarkdownDescription: attrETagDescription,
+				Computed:            true,
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "The object last modification date.",
+				Computed:            true,
+			},
+			AttrMetadata: schema.MapAttribute{
+				MarkdownDescription: attrMetadataDescription,
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			AttrVersionID: schema.StringAttribute{
+				MarkdownDescription: attrVersionIDDescription,
+				Computed:            true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: attrZoneDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up datasource dependencies.
+func (d *DataSourceSOSObject) Configure(
+	ctx context.Context,
+	r datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if r.ProviderData == nil {
+		return
+	}
+
+	d.baseConfig = &r.ProviderData.(*providerConfig.ExoscaleProviderConfig).Config
+}
+
+func (d *DataSourceSOSObject) NewSOSClient(ctx context.Context, zone string) (*s3.Client, error) {
+	return sos.NewSOSClient(ctx, zone, d.baseConfig.SOSEndpoint, d.baseConfig.Key, d.baseConfig.Secret)
+}
+
+// Read defines how the data source updates Terraform's state to reflect the retrieved data.
+func (d *DataSourceSOSObject) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan DataSourceSOSObjectModel
+
+	// Load Terraform plan into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := d.NewSOSClient(ctx, plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	// Read remote state.
+	object, err := sosClient.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: plan.Bucket.ValueStringPointer(),
+		Key:    plan.Key.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to get object",
+			err.Error(),
+		)
+		return
+	}
+	defer object.Body.Close()
+
+	if size := aws.ToInt64(object.ContentLength); size > maxObjectBodySize {
+		resp.Diagnostics.AddError(
+			"object is too large",
+			fmt.Sprintf("object size is %d bytes, the maximum supported size is %d bytes", size, maxObjectBodySize),
+		)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(object.Body, maxObjectBodySize))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to read object",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Body = types.StringNull()
+	if utf8.Valid(body) {
+		plan.Body = types.StringValue(string(body))
+	}
+	plan.BodyBase64 = types.StringValue(base64.StdEncoding.EncodeToString(body))
+	plan.ContentLength = types.Int64Value(int64(len(body)))
+	plan.ContentType = types.StringPointerValue(object.ContentType)
+	plan.ETag = types.StringValue(trimETag(object.ETag))
+	plan.VersionID = types.StringPointerValue(object.VersionId)
+
+	plan.LastModified = types.StringNull()
+	if object.LastModified != nil {
+		plan.LastModified = types.StringValue(object.LastModified.Format(time.RFC3339))
+	}
+
+	metadata, diags := types.MapValueFrom(ctx, types.StringType, object.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Metadata = metadata
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "datasource read done", map[string]any{
+		AttrBucket: plan.Bucket,
+		AttrKey:    plan.Key,
+	})
+}
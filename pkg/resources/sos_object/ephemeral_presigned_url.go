@@ -0,0 +1,278 @@
+package sos_object
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+)
+
+const (
+	// defaultPresignedURLExpiry is the presigned URL validity when none is configured.
+	defaultPresignedURLExpiry = 15 * time.Minute
+
+	// maxPresignedURLExpiry is the longest validity allowed by the SigV4 query signature.
+	maxPresignedURLExpiry = 7 * 24 * time.Hour
+)
+
+var presignedURLMethods = []string{
+	http.MethodGet,
+	http.MethodPut,
+	http.MethodHead,
+	http.MethodDelete,
+}
+
+var _ ephemeral.EphemeralResource = &EphemeralPresignedURL{}
+var _ ephemeral.EphemeralResourceWithConfigure = &EphemeralPresignedURL{}
+
+// EphemeralPresignedURL generates a presigned URL to an SOS object without persisting it in state.
+type EphemeralPresignedURL struct {
+	baseConfig *providerConfig.BaseConfig
+}
+
+type EphemeralPresignedURLModel struct {
+	Bucket    types.String   `tfsdk:"bucket"`
+	Key       types.String   `tfsdk:"key"`
+	Zone      types.String   `tfsdk:"zone"`
+	Method    types.String   `tfsdk:"method"`
+	ExpiresIn types.String   `tfsdk:"expires_in"`
+	ExpiresAt types.String   `tfsdk:"expires_at"`
+	URL       types.String   `tfsdk:"url"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewEphemeralPresignedURL() ephemeral.EphemeralResource {
+	return &EphemeralPresignedURL{}
+}
+
+func (r *EphemeralPresignedURL) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sos_presigned_url"
+}
+
+func (r *EphemeralPresignedURL) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generate a presigned URL granting temporary access to an Exoscale [SOS](https://community.exoscale.com/product/storage/object-storage/) Object (e.g. to let an instance fetch a private artefact at boot).\n\n" +
+			"The URL is signed locally with the provider credentials and is never persisted in the Terraform state or plan. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			AttrBucket: schema.StringAttribute{
+				MarkdownDescription: attrBucketDescription,
+				Required:            true,
+			},
+			AttrKey: schema.StringAttribute{
+				MarkdownDescription: attrKeyDescription,
+				Required:            true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: attrZoneDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method the URL is valid for (`GET`, `PUT`, `HEAD` or `DELETE`; default: `GET`).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(presignedURLMethods...),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				MarkdownDescription: "The URL validity, as a [duration](https://pkg.go.dev/time#ParseDuration) such as `30m` or `2h` (default: `15m`; maximum: `168h`). When the provider uses `assume_role`, it is capped to the lifetime of the temporary credentials the URL is signed with.",
+				Optional:            true,
+				Validators: []validator.String{
+					presignedURLExpiryValidator{},
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The URL expiration date (RFC3339).",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The presigned URL.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+func (r *EphemeralPresignedURL) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.baseConfig = &req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Config
+}
+
+func (r *EphemeralPresignedURL) NewSOSClient(ctx context.Context, zone string) (*s3.Client, error) {
+	return sos.NewSOSClient(ctx, zone, r.baseConfig.SOSEndpoint, r.baseConfig.Key, r.baseConfig.Secret)
+}
+
+func (r *EphemeralPresignedURL) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralPresignedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Open(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The value was checked by presignedURLExpiryValidator.
+	expiry, err := parsePresignedURLExpiry(data.ExpiresIn.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expires_in"), "invalid expires_in", err.Error())
+		return
+	}
+
+	// The URL is no longer valid once the credentials it is signed with have expired.
+	if keyExpiresAt := r.baseConfig.KeyExpiresAt; !keyExpiresAt.IsZero() {
+		remaining := time.Until(keyExpiresAt).Truncate(time.Second)
+		if remaining <= 0 {
+			resp.Diagnostics.AddError(
+				"failed to presign URL",
+				"the provider assume_role credentials have expired",
+			)
+			return
+		}
+
+		if remaining < expiry {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("expires_in"),
+				"URL validity reduced",
+				fmt.Sprintf(
+					"The URL is valid for %s only: it is signed with the provider assume_role credentials, which expire at %s.",
+					remaining,
+					keyExpiresAt.UTC().Format(time.RFC3339),
+				),
+			)
+			expiry = remaining
+		}
+	}
+
+	sosClient, err := r.NewSOSClient(ctx, data.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	method := data.Method.ValueString()
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	signedAt := time.Now()
+	request, err := presignObjectRequest(
+		ctx,
+		s3.NewPresignClient(sosClient, s3.WithPresignExpires(expiry)),
+		method,
+		data.Bucket.ValueStringPointer(),
+		data.Key.ValueStringPointer(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to presign URL",
+			err.Error(),
+		)
+		return
+	}
+
+	data.URL = types.StringValue(request.URL)
+	data.ExpiresAt = types.StringValue(signedAt.Add(expiry).UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	tflog.Debug(ctx, "presigned URL generated", map[string]any{
+		AttrBucket: data.Bucket.ValueString(),
+		AttrKey:    data.Key.ValueString(),
+		"method":   method,
+	})
+}
+
+// presignObjectRequest presigns the request of the given HTTP method on an object.
+func presignObjectRequest(
+	ctx context.Context,
+	client *s3.PresignClient,
+	method string,
+	bucket, key *string,
+) (*v4.PresignedHTTPRequest, error) {
+	switch method {
+	case http.MethodGet:
+		return client.PresignGetObject(ctx, &s3.GetObjectInput{Bucket: bucket, Key: key})
+	case http.MethodPut:
+		return client.PresignPutObject(ctx, &s3.PutObjectInput{Bucket: bucket, Key: key})
+	case http.MethodHead:
+		return client.PresignHeadObject(ctx, &s3.HeadObjectInput{Bucket: bucket, Key: key})
+	case http.MethodDelete:
+		return client.PresignDeleteObject(ctx, &s3.DeleteObjectInput{Bucket: bucket, Key: key})
+	}
+
+	return nil, fmt.Errorf("unsupported method %q", method)
+}
+
+// parsePresignedURLExpiry parses the configured URL validity,
+// falling back to defaultPresignedURLExpiry when unset.
+func parsePresignedURLExpiry(v string) (time.Duration, error) {
+	if v == "" {
+		return defaultPresignedURLExpiry, nil
+	}
+
+	expiry, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, err
+	}
+
+	if expiry <= 0 || expiry > maxPresignedURLExpiry {
+		return 0, fmt.Errorf("validity must be positive and at most %s, got %s", maxPresignedURLExpiry, expiry)
+	}
+
+	return expiry, nil
+}
+
+// presignedURLExpiryValidator validates the expires_in duration at plan time.
+type presignedURLExpiryValidator struct{}
+
+var _ validator.String = presignedURLExpiryValidator{}
+
+func (v presignedURLExpiryValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Value must be a positive duration of at most %s.", maxPresignedURLExpiry)
+}
+
+func (v presignedURLExpiryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v presignedURLExpiryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parsePresignedURLExpiry(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid URL validity", err.Error())
+	}
+}
@@ -0,0 +1,72 @@
+package sos_object
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParsePresignedURLExpiry(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "", want: defaultPresignedURLExpiry},
+		{value: "30m", want: 30 * time.Minute},
+		{value: "168h", want: maxPresignedURLExpiry},
+		{value: "169h", wantErr: true},
+		{value: "0s", wantErr: true},
+		{value: "-1h", wantErr: true},
+		{value: "1 day", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parsePresignedURLExpiry(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPresignedURLExpiryValidator(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("2h")},
+		{value: types.StringValue("169h"), wantErr: true},
+		{value: types.StringValue("1 day"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			presignedURLExpiryValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("expires_in"),
+				ConfigValue: tt.value,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("expected error %v, got %v: %v", tt.wantErr, got, resp.Diagnostics)
+			}
+		})
+	}
+}
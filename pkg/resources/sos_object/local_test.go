@@ -0,0 +1,22 @@
+//go:build local_integration
+
+package sos_object_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var flagAccount = flag.String("account", testutils.DefaultLocalAccount, "account name substring in exoscale.toml")
+
+func TestSOSObjectLocal(t *testing.T) {
+	testutils.LoadLocalCreds(t, *flagAccount)
+	TestSOSObject(t)
+}
+
+func TestSOSPresignedURLLocal(t *testing.T) {
+	testutils.LoadLocalCreds(t, *flagAccount)
+	TestSOSPresignedURL(t)
+}
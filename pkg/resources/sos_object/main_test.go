@@ -0,0 +1,168 @@
+package sos_object_test
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func TestSOSObject(t *testing.T) {
+	t.Parallel()
+
+	objectResourceName := "exoscale_sos_object.test_object"
+	objectDataSourceName := "data." + objectResourceName
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+	bucketName := fmt.Sprintf("terraform-provider-test-%d", testdataSpec.ID)
+	objectKey := "scripts/bootstrap.sh"
+
+	content := "#!/bin/sh\necho hello\n"
+	contentUpdated := "#!/bin/sh\necho updated\n"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// 1 Create object
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.object_create.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(objectResourceName, "etag", md5sum(content)),
+					resource.TestCheckResourceAttrSet(objectResourceName, "content_type"),
+					resource.TestCheckResourceAttr(objectResourceName, "metadata.owner", "terraform"),
+					resource.TestCheckResourceAttr(objectDataSourceName, "body", content),
+					resource.TestCheckResourceAttrPair(objectDataSourceName, "etag", objectResourceName, "etag"),
+					resource.TestCheckResourceAttr(objectDataSourceName, "content_length", fmt.Sprint(len(content))),
+					resource.TestCheckResourceAttr(objectDataSourceName, "metadata.owner", "terraform"),
+				),
+			},
+			// 2 Update object content
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.object_update.tf.tmpl", &testdataSpec),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(objectResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(objectResourceName, "etag", md5sum(contentUpdated)),
+					resource.TestCheckResourceAttr(objectResourceName, "content_type", "text/x-shellscript"),
+					resource.TestCheckNoResourceAttr(objectResourceName, "metadata"),
+					resource.TestCheckResourceAttr(objectDataSourceName, "body", contentUpdated),
+					resource.TestCheckResourceAttr(objectDataSourceName, "content_type", "text/x-shellscript"),
+				),
+			},
+			// 3 Restore object modified outside of Terraform
+			{
+				PreConfig: func() {
+					if err := putSOSObject(testdataSpec.Zone, bucketName, objectKey, "modified"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testutils.ParseTestdataConfig("./testdata/002.object_update.tf.tmpl", &testdataSpec),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(objectResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(objectResourceName, "etag", md5sum(contentUpdated)),
+					resource.TestCheckResourceAttr(objectDataSourceName, "body", contentUpdated),
+				),
+			},
+			// Import
+			{
+				ResourceName: objectResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						return fmt.Sprintf("%s/%s@%s", bucketName, objectKey, testdataSpec.Zone), nil
+					}
+				}(),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "key",
+				ImportStateVerifyIgnore:              []string{"content", "timeouts"},
+			},
+		},
+	})
+}
+
+func TestSOSPresignedURL(t *testing.T) {
+	t.Parallel()
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+	bucketName := fmt.Sprintf("terraform-provider-test-%d-url", testdataSpec.ID)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/003.presigned_url.tf.tmpl", &testdataSpec),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("url"),
+						knownvalue.StringRegexp(regexp.MustCompile(
+							fmt.Sprintf(`^https://sos-%s\.exo\.io/%s/artefacts/app\.tar\.gz\?.*X-Amz-Expires=600`,
+								regexp.QuoteMeta(testdataSpec.Zone), bucketName),
+						)),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("expires_at"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func md5sum(content string) string {
+	sum := md5.Sum([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func putSOSObject(zone, bucket, key, content string) error {
+	ctx := context.Background()
+	client, err := sos.NewSOSClient(ctx, zone, "", os.Getenv("EXOSCALE_API_KEY"), os.Getenv("EXOSCALE_API_SECRET"))
+	if err != nil {
+		return fmt.Errorf("unable to initialize SOS client: %w", err)
+	}
+
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+		Body:   strings.NewReader(content),
+	})
+
+	return err
+}
@@ -0,0 +1,557 @@
+package sos_object
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/sos"
+)
+
+const ResourceSOSObjectDescription = `Manage Exoscale [SOS](https://community.exoscale.com/product/storage/object-storage/) Objects.
+
+This resource is meant for small objects such as scripts or configuration files. The object is uploaded again whenever its content, as identified by its ETag, differs from the configured content.
+
+~> **NOTE:** in versioned buckets, destroying this resource only adds a delete marker: the previous versions of the object are kept.
+
+Corresponding data source: [exoscale_sos_object](../data-sources/sos_object.md).
+`
+
+// metadataKeyRegexp matches the metadata keys SOS preserves as-is.
+var metadataKeyRegexp = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSOSObject{}
+var _ resource.ResourceWithImportState = &ResourceSOSObject{}
+var _ resource.ResourceWithModifyPlan = &ResourceSOSObject{}
+
+// ResourceSOSObject defines the resource implementation.
+type ResourceSOSObject struct {
+	baseConfig *providerConfig.BaseConfig
+}
+
+// NewResourceSOSObject creates instance of ResourceSOSObject.
+func NewResourceSOSObject() resource.Resource {
+	return &ResourceSOSObject{}
+}
+
+// ResourceSOSObjectModel defines the resource data model.
+type ResourceSOSObjectModel struct {
+	Bucket      types.String `tfsdk:"bucket"`
+	Key         types.String `tfsdk:"key"`
+	Content     types.String `tfsdk:"content"`
+	Source      types.String `tfsdk:"source"`
+	ContentType types.String `tfsdk:"content_type"`
+	Metadata    types.Map    `tfsdk:"metadata"`
+	ETag        types.String `tfsdk:"etag"`
+	VersionID   types.String `tfsdk:"version_id"`
+	Zone        types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSOSObject) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sos_object"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceSOSObject) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceSOSObjectDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrBucket: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrBucketDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrKey: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrKeyDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The object content (UTF-8 string, conflicts with `source`).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source")),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The path of a local file to upload as the object content (conflicts with `content`).",
+				Optional:            true,
+			},
+			AttrContentType: schema.StringAttribute{
+				MarkdownDescription: attrContentTypeDescription + " Defaults to the type detected by SOS.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrMetadata: schema.MapAttribute{
+				MarkdownDescription: attrMetadataDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(metadataKeyRegexp, "must only contain lower-case alphanumeric characters and dashes"),
+					),
+				},
+			},
+			AttrETag: schema.StringAttribute{
+				MarkdownDescription: attrETagDescription,
+				Computed:            true,
+			},
+			AttrVersionID: schema.StringAttribute{
+				MarkdownDescription: attrVersionIDDescription,
+				Computed:            true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceSOSObject) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.baseConfig = &req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Config
+}
+
+func (r *ResourceSOSObject) NewSOSClient(ctx context.Context, zone string) (*s3.Client, error) {
+	return sos.NewSOSClient(ctx, zone, r.baseConfig.SOSEndpoint, r.baseConfig.Key, r.baseConfig.Secret)
+}
+
+// ModifyPlan sets the expected ETag from the configured content, so that
+// a difference with the remote object ETag triggers a new upload.
+func (r *ResourceSOSObject) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ResourceSOSObjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, err := contentETag(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to compute object ETag",
+			err.Error(),
+		)
+		return
+	}
+
+	// Content is not known yet, ETag will be known after apply.
+	if etag == "" {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(AttrETag), types.StringValue(etag))...)
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceSOSObject) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSOSObjectModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := putObject(ctx, sosClient, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"failed to put object",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]any{
+		AttrBucket: plan.Bucket,
+		AttrKey:    plan.Key,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceSOSObject) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSOSObjectModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	object, err := sosClient.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+		Key:    state.Key.ValueStringPointer(),
+	})
+	if err != nil {
+		if sos.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to get object",
+			err.Error(),
+		)
+		return
+	}
+
+	// The content itself is not read back: a remote change is detected
+	// through the ETag, which differs from the planned one.
+	state.ETag = types.StringValue(trimETag(object.ETag))
+	state.VersionID = types.StringPointerValue(object.VersionId)
+	state.ContentType = types.StringPointerValue(object.ContentType)
+
+	state.Metadata = types.MapNull(types.StringType)
+	if len(object.Metadata) > 0 {
+		metadata, diags := types.MapValueFrom(ctx, types.StringType, object.Metadata)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Metadata = metadata
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]any{
+		AttrBucket: state.Bucket,
+		AttrKey:    state.Key,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceSOSObject) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceSOSObjectModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	// Object content and metadata cannot be changed in place, the object is uploaded again.
+	if !plan.Content.Equal(state.Content) ||
+		!plan.Source.Equal(state.Source) ||
+		!plan.ContentType.Equal(state.ContentType) ||
+		!plan.Metadata.Equal(state.Metadata) ||
+		!plan.ETag.Equal(state.ETag) {
+		if err := putObject(ctx, sosClient, &plan); err != nil {
+			resp.Diagnostics.AddError(
+				"failed to put object",
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		plan.VersionID = state.VersionID
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]any{
+		AttrBucket: plan.Bucket,
+		AttrKey:    plan.Key,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceSOSObject) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSOSObjectModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sosClient, err := r.NewSOSClient(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create SOS client",
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = sosClient.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+		Key:    state.Key.ValueStringPointer(),
+	})
+	if err != nil {
+		if sos.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"failed to delete object",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]any{
+		AttrBucket: state.Bucket,
+		AttrKey:    state.Key,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceSOSObject) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bucket, key, zone, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	var state ResourceSOSObjectModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.Bucket = types.StringValue(bucket)
+	state.Key = types.StringValue(key)
+	state.Zone = types.StringValue(zone)
+	state.Metadata = types.MapNull(types.StringType)
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]any{
+		AttrBucket: state.Bucket,
+		AttrKey:    state.Key,
+	})
+}
+
+// parseImportID parses import identifiers with format bucket/key@zone,
+// the key may contain slashes.
+func parseImportID(id string) (bucket, key, zone string, err error) {
+	at := strings.LastIndex(id, "@")
+	if at != -1 {
+		bucket, key, _ = strings.Cut(id[:at], "/")
+		zone = id[at+1:]
+	}
+
+	if bucket == "" || key == "" || zone == "" {
+		return "", "", "", fmt.Errorf("Expected import identifier with format: bucket/key@zone. Got: %q", id)
+	}
+
+	return bucket, key, zone, nil
+}
+
+// putObject uploads the object content and updates the computed attributes of data.
+func putObject(ctx context.Context, sosClient *s3.Client, data *ResourceSOSObjectModel) error {
+	input := &s3.PutObjectInput{
+		Bucket: data.Bucket.ValueStringPointer(),
+		Key:    data.Key.ValueStringPointer(),
+	}
+
+	if !data.Source.IsNull() {
+		f, err := os.Open(data.Source.ValueString())
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		input.Body = f
+	} else {
+		input.Body = strings.NewReader(data.Content.ValueString())
+	}
+
+	if !data.ContentType.IsUnknown() && !data.ContentType.IsNull() {
+		input.ContentType = data.ContentType.ValueStringPointer()
+	}
+
+	if !data.Metadata.IsNull() {
+		metadata := map[string]string{}
+		if diags := data.Metadata.ElementsAs(ctx, &metadata, false); diags.HasError() {
+			return fmt.Errorf("invalid metadata: %v", diags)
+		}
+		input.Metadata = metadata
+	}
+
+	out, err := sosClient.PutObject(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	data.ETag = types.StringValue(trimETag(out.ETag))
+	data.VersionID = types.StringPointerValue(out.VersionId)
+
+	// Content type is detected by SOS when not set.
+	if data.ContentType.IsUnknown() || data.ContentType.IsNull() {
+		object, err := sosClient.HeadObject(ctx, &s3.HeadObjectInput{
+			Bucket: data.Bucket.ValueStringPointer(),
+			Key:    data.Key.ValueStringPointer(),
+		})
+		if err != nil {
+			return err
+		}
+		data.ContentType = types.StringPointerValue(object.ContentType)
+	}
+
+	return nil
+}
+
+// contentETag returns the MD5 checksum of the configured object content,
+// or an empty string if the content is not known yet.
+func contentETag(data *ResourceSOSObjectModel) (string, error) {
+	if data.Content.IsUnknown() || data.Source.IsUnknown() {
+		return "", nil
+	}
+
+	hash := md5.New()
+
+	if !data.Source.IsNull() {
+		f, err := os.Open(data.Source.ValueString())
+		if err != nil {
+			// The file may be generated during apply.
+			if errors.Is(err, fs.ErrNotExist) {
+				return "", nil
+			}
+			return "", err
+		}
+		defer f.Close()
+
+		if _, err := io.Copy(hash, f); err != nil {
+			return "", err
+		}
+	} else {
+		hash.Write([]byte(data.Content.ValueString()))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// trimETag returns the ETag without its surrounding quotes.
+func trimETag(etag *string) string {
+	return strings.Trim(aws.ToString(etag), `"`)
+}
@@ -0,0 +1,89 @@
+package sos_object
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		id                string
+		bucket, key, zone string
+		wantErr           bool
+	}{
+		{id: "my-bucket/my-key@ch-gva-2", bucket: "my-bucket", key: "my-key", zone: "ch-gva-2"},
+		{id: "my-bucket/path/to/my@key@ch-gva-2", bucket: "my-bucket", key: "path/to/my@key", zone: "ch-gva-2"},
+		{id: "my-bucket@ch-gva-2", wantErr: true},
+		{id: "my-bucket/my-key", wantErr: true},
+		{id: "/my-key@ch-gva-2", wantErr: true},
+		{id: "my-bucket/my-key@", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			bucket, key, zone, err := parseImportID(tt.id)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if bucket != tt.bucket || key != tt.key || zone != tt.zone {
+				t.Errorf("expected (%q, %q, %q), got (%q, %q, %q)", tt.bucket, tt.key, tt.zone, bucket, key, zone)
+			}
+		})
+	}
+}
+
+func TestContentETag(t *testing.T) {
+	// md5("hello")
+	const expected = "5d41402abc4b2a76b9719d911017c592"
+
+	source := filepath.Join(t.TempDir(), "hello.txt")
+	if err := os.WriteFile(source, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		data     ResourceSOSObjectModel
+		expected string
+	}{
+		{
+			name:     "content",
+			data:     ResourceSOSObjectModel{Content: types.StringValue("hello"), Source: types.StringNull()},
+			expected: expected,
+		},
+		{
+			name:     "source",
+			data:     ResourceSOSObjectModel{Content: types.StringNull(), Source: types.StringValue(source)},
+			expected: expected,
+		},
+		{
+			name: "unknown content",
+			data: ResourceSOSObjectModel{Content: types.StringUnknown(), Source: types.StringNull()},
+		},
+		{
+			name: "missing source",
+			data: ResourceSOSObjectModel{Content: types.StringNull(), Source: types.StringValue(source + ".missing")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := contentETag(&tt.data)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+//go:build local_integration
+
+package template_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var flagAccount = flag.String("account", testutils.DefaultLocalAccount, "account name substring in exoscale.toml")
+
+func TestTemplateLocal(t *testing.T) {
+	testutils.LoadLocalCreds(t, *flagAccount)
+	TestTemplate(t)
+}
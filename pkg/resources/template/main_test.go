@@ -0,0 +1,150 @@
+package template_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	egoscale "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+func TestTemplate(t *testing.T) {
+	t.Parallel()
+
+	templateResourceName := "exoscale_template.test_template"
+	templateDataSourceName := "data." + templateResourceName
+	var templateID string
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckTemplateDestroy(testdataSpec.Zone, &templateID),
+			testAccCheckTemplateDestroy("ch-dk-2", &templateID),
+		),
+		Steps: []resource.TestStep{
+			// 1 Promote instance snapshot to template
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.template_from_snapshot.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(templateResourceName, "id", func(v string) error {
+						templateID = v
+						return nil
+					}),
+					resource.TestCheckResourceAttr(
+						templateResourceName,
+						"name",
+						fmt.Sprintf("terraform-provider-test-%d", testdataSpec.ID),
+					),
+					resource.TestCheckResourceAttr(templateResourceName, "description", testutils.TestDescription),
+					resource.TestCheckResourceAttr(templateResourceName, "default_user", "ubuntu"),
+					resource.TestCheckResourceAttr(templateResourceName, "ssh_key_enabled", "true"),
+					resource.TestCheckResourceAttr(templateResourceName, "password_enabled", "false"),
+					resource.TestCheckResourceAttr(templateResourceName, "visibility", "private"),
+					resource.TestCheckResourceAttrSet(templateResourceName, "size"),
+					resource.TestCheckResourceAttrSet(templateResourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(templateDataSourceName, "name", templateResourceName, "name"),
+					resource.TestCheckResourceAttr(templateDataSourceName, "description", testutils.TestDescription),
+					resource.TestCheckResourceAttr(templateDataSourceName, "default_user", "ubuntu"),
+					resource.TestCheckResourceAttr(templateDataSourceName, "password_enabled", "false"),
+				),
+			},
+			// 2 Update template name and description, replicate to another zone
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.template_update.tf.tmpl", &testdataSpec),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(templateResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						templateResourceName,
+						"name",
+						fmt.Sprintf("terraform-provider-test-%d-renamed", testdataSpec.ID),
+					),
+					resource.TestCheckResourceAttr(templateResourceName, "description", "Updated by the terraform-exoscale provider"),
+					resource.TestCheckResourceAttr(templateResourceName, "replica_zones.#", "1"),
+					resource.TestCheckTypeSetElemAttr(templateResourceName, "replica_zones.*", "ch-dk-2"),
+					resource.TestCheckResourceAttrPair(templateDataSourceName, "id", templateResourceName, "id"),
+				),
+			},
+			// Import
+			{
+				ResourceName: templateResourceName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", s.RootModule().Resources[templateResourceName].Primary.ID, testdataSpec.Zone), nil
+					}
+				}(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"snapshot_id", "replica_zones", "timeouts"},
+			},
+		},
+	})
+}
+
+func TestTemplateInvalidSnapshotAttributes(t *testing.T) {
+	t.Parallel()
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testutils.ParseTestdataConfig("./testdata/003.template_invalid_snapshot_attributes.tf.tmpl", &testdataSpec),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(zone string, templateID *string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if templateID == nil || *templateID == "" {
+			return errors.New("template ID was not captured during the test")
+		}
+
+		defaultClient, err := testutils.APIClientV3()
+		if err != nil {
+			return fmt.Errorf("unable to initialize Exoscale client: %w", err)
+		}
+
+		ctx := context.Background()
+		client, err := utils.SwitchClientZone(ctx, defaultClient, egoscale.ZoneName(zone))
+		if err != nil {
+			return fmt.Errorf("unable to initialize Exoscale client: %w", err)
+		}
+
+		_, err = client.GetTemplate(ctx, egoscale.UUID(*templateID))
+		if err != nil {
+			if errors.Is(err, egoscale.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("template still exists in zone %s", zone)
+	}
+}
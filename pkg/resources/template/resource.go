@@ -0,0 +1,821 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const ResourceTemplateDescription = `Manage Exoscale [Compute Instance Templates](https://community.exoscale.com/product/compute/instances/how-to/custom-templates/).
+
+A custom template is either registered from a disk image URL, or promoted from a [compute instance snapshot](./compute_instance_snapshot.md). It can be replicated into other zones with ` + "`replica_zones`" + `.
+
+Corresponding data source: [exoscale_template](../data-sources/template.md).`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceTemplate{}
+var _ resource.ResourceWithImportState = &ResourceTemplate{}
+
+// ResourceTemplate defines the resource implementation.
+type ResourceTemplate struct {
+	client *exoscale.Client
+}
+
+// NewResourceTemplate creates instance of ResourceTemplate.
+func NewResourceTemplate() resource.Resource {
+	return &ResourceTemplate{}
+}
+
+// ResourceTemplateModel defines the resource data model.
+type ResourceTemplateModel struct {
+	ID                                   types.String `tfsdk:"id"`
+	Zone                                 types.String `tfsdk:"zone"`
+	Name                                 types.String `tfsdk:"name"`
+	Description                          types.String `tfsdk:"description"`
+	URL                                  types.String `tfsdk:"url"`
+	Checksum                             types.String `tfsdk:"checksum"`
+	SnapshotID                           types.String `tfsdk:"snapshot_id"`
+	BootMode                             types.String `tfsdk:"boot_mode"`
+	DefaultUser                          types.String `tfsdk:"default_user"`
+	SSHKeyEnabled                        types.Bool   `tfsdk:"ssh_key_enabled"`
+	PasswordEnabled                      types.Bool   `tfsdk:"password_enabled"`
+	ApplicationConsistentSnapshotEnabled types.Bool   `tfsdk:"application_consistent_snapshot_enabled"`
+	Build                                types.String `tfsdk:"build"`
+	Version                              types.String `tfsdk:"version"`
+	Maintainer                           types.String `tfsdk:"maintainer"`
+	ReplicaZones                         types.Set    `tfsdk:"replica_zones"`
+	Size                                 types.Int64  `tfsdk:"size"`
+	CreatedAt                            types.String `tfsdk:"created_at"`
+	Visibility                           types.String `tfsdk:"visibility"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceTemplate) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceTemplate) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Attributes only supported when registering a template from a URL.
+	registerOnly := []validator.String{
+		stringvalidator.ConflictsWith(path.MatchRoot("snapshot_id")),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceTemplateDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The template name.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A free-form text describing the template (once set, it cannot be cleared).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "❗ The URL of the disk image to register (conflicts with `snapshot_id`).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("snapshot_id")),
+					stringvalidator.AlsoRequires(path.MatchRoot("checksum")),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "❗ The MD5 checksum of the disk image (required with `url`).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: registerOnly,
+			},
+			"snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "❗ The [exoscale_compute_instance_snapshot](./compute_instance_snapshot.md) ID to promote to a template (conflicts with `url`).",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"boot_mode": schema.StringAttribute{
+				MarkdownDescription: "❗ The template boot mode (`legacy` or `uefi`; default: `legacy`). Only supported with `url`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: append([]validator.String{
+					stringvalidator.OneOf(
+						string(exoscale.RegisterTemplateRequestBootModeLegacy),
+						string(exoscale.RegisterTemplateRequestBootModeUefi),
+					),
+				}, registerOnly...),
+			},
+			"default_user": schema.StringAttribute{
+				MarkdownDescription: "❗ The username to use to log into a compute instance based on this template.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssh_key_enabled": schema.BoolAttribute{
+				MarkdownDescription: "❗ Enable SSH key-based login (boolean; default: `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"password_enabled": schema.BoolAttribute{
+				MarkdownDescription: "❗ Enable password-based login (boolean; default: `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_consistent_snapshot_enabled": schema.BoolAttribute{
+				MarkdownDescription: "❗ Whether the template has the QEMU guest agent installed, allowing application-consistent snapshots. Only supported with `url`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIfConfigured(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("snapshot_id")),
+				},
+			},
+			"build": schema.StringAttribute{
+				MarkdownDescription: "❗ The template build. Only supported with `url`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: registerOnly,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "❗ The template version. Only supported with `url`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: registerOnly,
+			},
+			"maintainer": schema.StringAttribute{
+				MarkdownDescription: "❗ The template maintainer. Only supported with `url`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: registerOnly,
+			},
+			"replica_zones": schema.SetAttribute{
+				MarkdownDescription: "Additional zones to copy the template into. Removing a zone deletes the copy from that zone.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(config.Zones...)),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "The template size (bytes).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The template creation date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "The template visibility (`private` for custom templates).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceTemplate) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceTemplate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceTemplateModel
+
+	// Load Terraform plan into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	var op *exoscale.Operation
+	if !plan.SnapshotID.IsNull() {
+		snapshotID, err := exoscale.ParseUUID(plan.SnapshotID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to parse snapshot ID",
+				err.Error(),
+			)
+			return
+		}
+
+		op, err = client.PromoteSnapshotToTemplate(ctx, snapshotID, exoscale.PromoteSnapshotToTemplateRequest{
+			Name:            plan.Name.ValueString(),
+			Description:     plan.Description.ValueString(),
+			DefaultUser:     plan.DefaultUser.ValueString(),
+			SSHKeyEnabled:   plan.SSHKeyEnabled.ValueBoolPointer(),
+			PasswordEnabled: plan.PasswordEnabled.ValueBoolPointer(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to promote snapshot to template",
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		request := exoscale.RegisterTemplateRequest{
+			Name:            plan.Name.ValueString(),
+			Description:     plan.Description.ValueString(),
+			URL:             plan.URL.ValueString(),
+			Checksum:        plan.Checksum.ValueString(),
+			BootMode:        exoscale.RegisterTemplateRequestBootMode(plan.BootMode.ValueString()),
+			DefaultUser:     plan.DefaultUser.ValueString(),
+			SSHKeyEnabled:   plan.SSHKeyEnabled.ValueBoolPointer(),
+			PasswordEnabled: plan.PasswordEnabled.ValueBoolPointer(),
+			Build:           plan.Build.ValueString(),
+			Version:         plan.Version.ValueString(),
+			Maintainer:      plan.Maintainer.ValueString(),
+		}
+		if !plan.ApplicationConsistentSnapshotEnabled.IsUnknown() {
+			request.ApplicationConsistentSnapshotEnabled = plan.ApplicationConsistentSnapshotEnabled.ValueBoolPointer()
+		}
+
+		op, err = client.RegisterTemplate(ctx, request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to register template",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	op, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to create template",
+			err.Error(),
+		)
+		return
+	}
+
+	if op.Reference == nil {
+		resp.Diagnostics.AddError(
+			"failed to create template",
+			"operation did not reference the created template",
+		)
+		return
+	}
+
+	// The template exists from now on, save it so it is not leaked on further errors.
+	plan.ID = types.StringValue(op.Reference.ID.String())
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), plan.Zone)...)
+	if !plan.ReplicaZones.IsNull() {
+		// No replica has been copied yet.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("replica_zones"), []string{})...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var replicaZones []string
+	resp.Diagnostics.Append(plan.ReplicaZones.ElementsAs(ctx, &replicaZones, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	copied := []string{}
+	for _, zone := range replicaZones {
+		if err := copyTemplate(ctx, client, op.Reference.ID, zone); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to copy template",
+				err.Error(),
+			)
+			break
+		}
+		copied = append(copied, zone)
+	}
+	if resp.Diagnostics.HasError() {
+		// Only track the replicas actually created, the resource will be tainted.
+		replicas, dg := types.SetValueFrom(ctx, types.StringType, copied)
+		resp.Diagnostics.Append(dg...)
+		plan.ReplicaZones = replicas
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("replica_zones"), plan.ReplicaZones)...)
+
+	template, err := client.GetTemplate(ctx, op.Reference.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get template",
+			err.Error(),
+		)
+		return
+	}
+
+	readTemplateIntoModel(template, &plan)
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]any{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceTemplate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceTemplateModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse template ID",
+			err.Error(),
+		)
+		return
+	}
+
+	template, err := client.GetTemplate(ctx, id)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to get template",
+			err.Error(),
+		)
+		return
+	}
+
+	readTemplateIntoModel(template, &state)
+
+	// Only keep track of the replicas still existing.
+	resp.Diagnostics.Append(r.refreshReplicaZones(ctx, id, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]any{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceTemplateModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse template ID",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		op, err := client.UpdateTemplate(ctx, id, exoscale.UpdateTemplateRequest{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to update template",
+				err.Error(),
+			)
+			return
+		}
+
+		_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to update template",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.ReplicaZones.Equal(state.ReplicaZones) {
+		var planZones, stateZones []string
+		resp.Diagnostics.Append(plan.ReplicaZones.ElementsAs(ctx, &planZones, false)...)
+		resp.Diagnostics.Append(state.ReplicaZones.ElementsAs(ctx, &stateZones, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, zone := range stateZones {
+			if slices.Contains(planZones, zone) {
+				continue
+			}
+			if err := deleteTemplate(ctx, r.client, id, zone); err != nil {
+				resp.Diagnostics.AddError(
+					"unable to delete template replica",
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		for _, zone := range planZones {
+			if slices.Contains(stateZones, zone) {
+				continue
+			}
+			if err := copyTemplate(ctx, client, id, zone); err != nil {
+				resp.Diagnostics.AddError(
+					"unable to copy template",
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	template, err := client.GetTemplate(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get template",
+			err.Error(),
+		)
+		return
+	}
+
+	readTemplateIntoModel(template, &plan)
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]any{
+		"id": plan.ID,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceTemplate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceTemplateModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse template ID",
+			err.Error(),
+		)
+		return
+	}
+
+	var replicaZones []string
+	resp.Diagnostics.Append(state.ReplicaZones.ElementsAs(ctx, &replicaZones, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the replicas first, then the template from its origin zone.
+	for _, zone := range append(replicaZones, state.Zone.ValueString()) {
+		if err := deleteTemplate(ctx, r.client, id, zone); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to delete template",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]any{
+		"id": state.ID,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceTemplate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceTemplateModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.ID = types.StringValue(idParts[0])
+	state.Zone = types.StringValue(idParts[1])
+
+	// Set null values
+	state.ReplicaZones = types.SetNull(types.StringType)
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]any{
+		"id": state.ID,
+	})
+}
+
+// refreshReplicaZones drops from the state the replica zones the template does not exist in anymore.
+func (r *ResourceTemplate) refreshReplicaZones(ctx context.Context, id exoscale.UUID, state *ResourceTemplateModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state.ReplicaZones.IsNull() {
+		return diags
+	}
+
+	var zones []string
+	diags.Append(state.ReplicaZones.ElementsAs(ctx, &zones, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing := []string{}
+	for _, zone := range zones {
+		client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(zone))
+		if err != nil {
+			diags.AddError("unable to change exoscale client zone", err.Error())
+			return diags
+		}
+
+		if _, err := client.GetTemplate(ctx, id); err != nil {
+			if errors.Is(err, exoscale.ErrNotFound) {
+				continue
+			}
+			diags.AddError("unable to get template replica", err.Error())
+			return diags
+		}
+
+		existing = append(existing, zone)
+	}
+
+	replicaZones, dg := types.SetValueFrom(ctx, types.StringType, existing)
+	diags.Append(dg...)
+	if !diags.HasError() {
+		state.ReplicaZones = replicaZones
+	}
+
+	return diags
+}
+
+// copyTemplate copies the template into the target zone and waits for the copy to complete.
+func copyTemplate(ctx context.Context, client *exoscale.Client, id exoscale.UUID, zone string) error {
+	op, err := client.CopyTemplate(ctx, id, exoscale.CopyTemplateRequest{
+		TargetZone: &exoscale.Zone{Name: exoscale.ZoneName(zone)},
+	})
+	if err != nil {
+		return fmt.Errorf("copy to zone %s: %w", zone, err)
+	}
+
+	if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+		return fmt.Errorf("copy to zone %s: %w", zone, err)
+	}
+
+	return nil
+}
+
+// deleteTemplate deletes the template from the given zone, a template already gone is not an error.
+func deleteTemplate(ctx context.Context, defaultClient *exoscale.Client, id exoscale.UUID, zone string) error {
+	client, err := utils.SwitchClientZone(ctx, defaultClient, exoscale.ZoneName(zone))
+	if err != nil {
+		return err
+	}
+
+	op, err := client.DeleteTemplate(ctx, id)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("delete from zone %s: %w", zone, err)
+	}
+
+	if _, err := client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+		return fmt.Errorf("delete from zone %s: %w", zone, err)
+	}
+
+	return nil
+}
+
+func readTemplateIntoModel(template *exoscale.Template, data *ResourceTemplateModel) {
+	data.ID = types.StringValue(template.ID.String())
+	data.Name = types.StringValue(template.Name)
+	data.Description = types.StringValue(template.Description)
+	data.URL = types.StringValue(template.URL)
+	data.Checksum = types.StringValue(template.Checksum)
+	data.BootMode = types.StringValue(string(template.BootMode))
+	data.DefaultUser = types.StringValue(template.DefaultUser)
+	data.SSHKeyEnabled = types.BoolValue(utils.DefaultBool(template.SSHKeyEnabled, false))
+	data.PasswordEnabled = types.BoolValue(utils.DefaultBool(template.PasswordEnabled, false))
+	data.ApplicationConsistentSnapshotEnabled = types.BoolValue(utils.DefaultBool(template.ApplicationConsistentSnapshotEnabled, false))
+	data.Build = types.StringValue(template.Build)
+	data.Version = types.StringValue(template.Version)
+	data.Maintainer = types.StringValue(template.Maintainer)
+	data.Size = types.Int64Value(template.Size)
+	data.CreatedAt = types.StringValue(template.CreatedAT.String())
+	data.Visibility = types.StringValue(string(template.Visibility))
+}
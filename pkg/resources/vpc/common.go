@@ -0,0 +1,117 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+)
+
+// parseImportID splits an import identifier of the form <ID>@<zone>.
+func parseImportID(importID string) (exoscale.UUID, string, error) {
+	idParts := strings.Split(importID, "@")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected import identifier with format: id@zone. Got: %q", importID)
+	}
+
+	id, err := exoscale.ParseUUID(idParts[0])
+	if err != nil {
+		return "", "", fmt.Errorf("unable to parse ID: %w", err)
+	}
+
+	if !slices.Contains(config.Zones, idParts[1]) {
+		return "", "", fmt.Errorf("zone must be a valid exoscale zone, got: %q", idParts[1])
+	}
+
+	return id, idParts[1], nil
+}
+
+// labelsFromModel converts a Terraform labels map to API labels.
+// It always returns a non-nil map, so that an update request clears labels removed from the configuration.
+func labelsFromModel(ctx context.Context, m types.Map) (exoscale.Labels, diag.Diagnostics) {
+	labels := exoscale.Labels{}
+	if m.IsNull() || m.IsUnknown() {
+		return labels, nil
+	}
+
+	diags := m.ElementsAs(ctx, &labels, false)
+
+	return labels, diags
+}
+
+// labelsToModel converts API labels to a Terraform labels map, empty labels being reported as null.
+func labelsToModel(ctx context.Context, labels exoscale.Labels) (types.Map, diag.Diagnostics) {
+	if len(labels) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, labels)
+}
+
+func optionalStringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// findSubnetVPC returns the ID of the VPC a subnet belongs to.
+// It is used to resolve the parent VPC of subnets imported by <ID>@<zone>.
+func findSubnetVPC(ctx context.Context, client *exoscale.Client, subnetID exoscale.UUID) (exoscale.UUID, error) {
+	vpcs, err := client.ListVpcs(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, vpc := range vpcs.Vpcs {
+		subnets, err := client.ListSubnets(ctx, vpc.ID)
+		if err != nil {
+			return "", err
+		}
+
+		for _, subnet := range subnets.Subnets {
+			if subnet.ID == subnetID {
+				return vpc.ID, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("subnet %q: %w", subnetID, exoscale.ErrNotFound)
+}
+
+// findRouteSubnet returns the IDs of the VPC and subnet a route belongs to.
+// It is used to resolve the parents of routes imported by <ID>@<zone>.
+func findRouteSubnet(ctx context.Context, client *exoscale.Client, routeID exoscale.UUID) (exoscale.UUID, exoscale.UUID, error) {
+	vpcs, err := client.ListVpcs(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, vpc := range vpcs.Vpcs {
+		subnets, err := client.ListSubnets(ctx, vpc.ID)
+		if err != nil {
+			return "", "", err
+		}
+
+		for _, subnet := range subnets.Subnets {
+			routes, err := client.ListRoutes(ctx, vpc.ID, subnet.ID)
+			if err != nil {
+				return "", "", err
+			}
+
+			for _, route := range routes.Routes {
+				if route.ID == routeID {
+					return vpc.ID, subnet.ID, nil
+				}
+			}
+		}
+	}
+
+	return "", "", fmt.Errorf("route %q: %w", routeID, exoscale.ErrNotFound)
+}
@@ -0,0 +1,155 @@
+package vpc
+
+import (
+	"context"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionDataSourceRoute = `Fetch Exoscale [VPC](https://community.exoscale.com/product/networking/vpc/) Routes data.
+
+Corresponding resource: [exoscale_vpc_route](../resources/vpc_route.md).`
+
+var _ datasource.DataSourceWithConfigure = (*DataSourceRoute)(nil)
+
+type DataSourceRoute struct {
+	client *exoscale.Client
+}
+
+func NewDataSourceRoute() datasource.DataSource {
+	return &DataSourceRoute{}
+}
+
+type DataSourceRouteModel struct {
+	ID          types.String `tfsdk:"id"`
+	Zone        types.String `tfsdk:"zone"`
+	VPCID       types.String `tfsdk:"vpc_id"`
+	Description types.String `tfsdk:"description"`
+	Destination types.String `tfsdk:"destination"`
+	Target      types.String `tfsdk:"target"`
+	Kind        types.String `tfsdk:"kind"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *DataSourceRoute) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_route"
+}
+
+func (d *DataSourceRoute) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescriptionDataSourceRoute,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The route ID to match.",
+				MarkdownDescription: "The route ID to match.",
+				Required:            true,
+			},
+			"zone": schema.StringAttribute{
+				Description:         "The Exoscale zone name.",
+				MarkdownDescription: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Description:         "The ID of the VPC the route belongs to.",
+				MarkdownDescription: "The [exoscale_vpc](../resources/vpc.md) (ID) the route belongs to.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				Description:         "The route description.",
+				MarkdownDescription: "The route description.",
+				Computed:            true,
+			},
+			"destination": schema.StringAttribute{
+				Description:         "The route destination network, in CIDR notation.",
+				MarkdownDescription: "The route destination network, in CIDR notation.",
+				Computed:            true,
+			},
+			"target": schema.StringAttribute{
+				Description:         "The route target (next hop).",
+				MarkdownDescription: "The route target (next hop).",
+				Computed:            true,
+			},
+			"kind": schema.StringAttribute{
+				Description:         "The route kind (Subnet or Vpc).",
+				MarkdownDescription: "The route kind (`Subnet` or `Vpc`).",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourceRoute) Configure(ctx context.Context, r datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if r.ProviderData == nil {
+		return
+	}
+
+	d.client = r.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (d *DataSourceRoute) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataSourceRouteModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	vpcID, err := exoscale.ParseUUID(state.VPCID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse VPC ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, d.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	routes, err := client.ListVpcRoutes(ctx, vpcID)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error while fetching VPC routes", err.Error())
+		return
+	}
+
+	route, err := routes.FindListRouteEntry(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("VPC route not found", err.Error())
+		return
+	}
+
+	state.Description = types.StringValue(route.Description)
+	state.Destination = types.StringValue(route.Destination)
+	state.Target = types.StringValue(route.Target)
+	state.Kind = types.StringValue(string(route.Kind))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
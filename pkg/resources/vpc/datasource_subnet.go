@@ -0,0 +1,203 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionDataSourceSubnet = `Fetch Exoscale [VPC](https://community.exoscale.com/product/networking/vpc/) Subnets data.
+
+Corresponding resource: [exoscale_vpc_subnet](../resources/vpc_subnet.md).`
+
+var _ datasource.DataSourceWithConfigure = (*DataSourceSubnet)(nil)
+
+type DataSourceSubnet struct {
+	client *exoscale.Client
+}
+
+func NewDataSourceSubnet() datasource.DataSource {
+	return &DataSourceSubnet{}
+}
+
+type DataSourceSubnetModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Zone          types.String `tfsdk:"zone"`
+	VPCID         types.String `tfsdk:"vpc_id"`
+	Description   types.String `tfsdk:"description"`
+	IPv4Block     types.String `tfsdk:"ipv4_block"`
+	AddressSpace  types.String `tfsdk:"address_space"`
+	AddressFamily types.String `tfsdk:"address_family"`
+	Labels        types.Map    `tfsdk:"labels"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *DataSourceSubnet) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_subnet"
+}
+
+func (d *DataSourceSubnet) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescriptionDataSourceSubnet,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The subnet ID to match (conflicts with 'name').",
+				MarkdownDescription: "The subnet ID to match (conflicts with `name`).",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("name"),
+					}...),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The subnet name to match (conflicts with 'id').",
+				MarkdownDescription: "The subnet name to match (conflicts with `id`).",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("id"),
+					}...),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "The Exoscale zone name.",
+				MarkdownDescription: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Description:         "The ID of the VPC the subnet belongs to.",
+				MarkdownDescription: "The [exoscale_vpc](../resources/vpc.md) (ID) the subnet belongs to.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				Description:         "The subnet description.",
+				MarkdownDescription: "The subnet description.",
+				Computed:            true,
+			},
+			"ipv4_block": schema.StringAttribute{
+				Description:         "The subnet IPv4 network, in CIDR notation.",
+				MarkdownDescription: "The subnet IPv4 network, in CIDR notation.",
+				Computed:            true,
+			},
+			"address_space": schema.StringAttribute{
+				Description:         "The subnet address space.",
+				MarkdownDescription: "The subnet address space.",
+				Computed:            true,
+			},
+			"address_family": schema.StringAttribute{
+				Description:         "The subnet address family.",
+				MarkdownDescription: "The subnet address family.",
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				Description:         "A map of key/value labels.",
+				MarkdownDescription: "A map of key/value labels.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				Description:         "The subnet creation date.",
+				MarkdownDescription: "The subnet creation date.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourceSubnet) Configure(ctx context.Context, r datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if r.ProviderData == nil {
+		return
+	}
+
+	d.client = r.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (d *DataSourceSubnet) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataSourceSubnetModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	vpcID, err := exoscale.ParseUUID(state.VPCID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse VPC ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, d.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	nameOrID := state.ID.ValueString()
+	if !state.Name.IsNull() {
+		nameOrID = state.Name.ValueString()
+	}
+
+	subnets, err := client.ListSubnets(ctx, vpcID)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error while fetching VPC subnets", err.Error())
+		return
+	}
+
+	subnet, err := subnets.FindListSubnetEntry(nameOrID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("VPC subnet %q not found", nameOrID), err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(subnet.ID.String())
+	state.Name = types.StringValue(subnet.Name)
+	state.Description = types.StringValue(subnet.Description)
+	state.IPv4Block = types.StringValue(subnet.Ipv4Block)
+	state.AddressSpace = types.StringValue(string(subnet.AddressSpace))
+	state.AddressFamily = types.StringValue(string(subnet.Addressfamily))
+	state.CreatedAt = types.StringValue(subnet.CreatedAT.String())
+
+	labels, diags := labelsToModel(ctx, subnet.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Labels = labels
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
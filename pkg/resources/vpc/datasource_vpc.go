@@ -0,0 +1,170 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionDataSourceVPC = `Fetch Exoscale [Virtual Private Clouds](https://community.exoscale.com/product/networking/vpc/) (VPC) data.
+
+Corresponding resource: [exoscale_vpc](../resources/vpc.md).`
+
+var _ datasource.DataSourceWithConfigure = (*DataSourceVPC)(nil)
+
+type DataSourceVPC struct {
+	client *exoscale.Client
+}
+
+func NewDataSourceVPC() datasource.DataSource {
+	return &DataSourceVPC{}
+}
+
+type DataSourceVPCModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Zone        types.String `tfsdk:"zone"`
+	Description types.String `tfsdk:"description"`
+	Labels      types.Map    `tfsdk:"labels"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *DataSourceVPC) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc"
+}
+
+func (d *DataSourceVPC) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescriptionDataSourceVPC,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "The VPC ID to match (conflicts with 'name').",
+				MarkdownDescription: "The VPC ID to match (conflicts with `name`).",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("name"),
+					}...),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The VPC name to match (conflicts with 'id').",
+				MarkdownDescription: "The VPC name to match (conflicts with `id`).",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("id"),
+					}...),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "The Exoscale zone name.",
+				MarkdownDescription: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description:         "The VPC description.",
+				MarkdownDescription: "The VPC description.",
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				Description:         "A map of key/value labels.",
+				MarkdownDescription: "A map of key/value labels.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				Description:         "The VPC creation date.",
+				MarkdownDescription: "The VPC creation date.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+func (d *DataSourceVPC) Configure(ctx context.Context, r datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if r.ProviderData == nil {
+		return
+	}
+
+	d.client = r.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (d *DataSourceVPC) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataSourceVPCModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, d.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	nameOrID := state.ID.ValueString()
+	if !state.Name.IsNull() {
+		nameOrID = state.Name.ValueString()
+	}
+
+	vpcs, err := client.ListVpcs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error while fetching VPCs", err.Error())
+		return
+	}
+
+	vpc, err := vpcs.FindListVpcEntry(nameOrID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("VPC %q not found", nameOrID), err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(vpc.ID.String())
+	state.Name = types.StringValue(vpc.Name)
+	state.Description = types.StringValue(vpc.Description)
+	state.CreatedAt = types.StringValue(vpc.CreatedAT.String())
+
+	labels, diags := labelsToModel(ctx, vpc.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Labels = labels
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,152 @@
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+	tftest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func Test_Resource_VPC(t *testing.T) {
+	t.Parallel()
+
+	resourceVPC := "exoscale_vpc.test_vpc"
+	resourceSubnet := "exoscale_vpc_subnet.test_subnet"
+	resourceRoute := "exoscale_vpc_route.test_route"
+	datasourceVPCByID := "data.exoscale_vpc.test_vpc_id"
+	datasourceVPCByName := "data.exoscale_vpc.test_vpc_name"
+	datasourceSubnet := "data.exoscale_vpc_subnet.test_subnet"
+	datasourceRoute := "data.exoscale_vpc_route.test_route"
+
+	testDataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	importStateIDFunc := func(resource string) func(s *terraform.State) (string, error) {
+		return func(s *terraform.State) (string, error) {
+			return fmt.Sprintf("%s@%s", s.RootModule().Resources[resource].Primary.ID, testDataSpec.Zone), nil
+		}
+	}
+
+	tftest.Test(t, tftest.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []tftest.TestStep{
+			// Create VPC, subnet, route and datasources
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.vpc_create.tf.tmpl", &testDataSpec),
+				Check: tftest.ComposeAggregateTestCheckFunc(
+					// test resources
+					tftest.TestCheckResourceAttr(resourceVPC, "name", testutils.ResourceName(testDataSpec.ID)),
+					tftest.TestCheckResourceAttr(resourceVPC, "description", "description-test"),
+					tftest.TestCheckResourceAttr(resourceVPC, "labels.%", "1"),
+					tftest.TestCheckResourceAttr(resourceVPC, "labels.A", "B"),
+					tftest.TestCheckResourceAttrSet(resourceVPC, "created_at"),
+
+					tftest.TestCheckResourceAttr(resourceSubnet, "name", testutils.ResourceName(testDataSpec.ID)),
+					tftest.TestCheckResourceAttr(resourceSubnet, "ipv4_block", "10.0.0.0/24"),
+					tftest.TestCheckResourceAttr(resourceSubnet, "address_space", "private"),
+					tftest.TestCheckResourceAttrPair(resourceSubnet, "vpc_id", resourceVPC, "id"),
+
+					tftest.TestCheckResourceAttr(resourceRoute, "destination", "192.168.0.0/24"),
+					tftest.TestCheckResourceAttr(resourceRoute, "target", "10.0.0.1"),
+					tftest.TestCheckResourceAttrPair(resourceRoute, "subnet_id", resourceSubnet, "id"),
+					tftest.TestCheckResourceAttrSet(resourceRoute, "kind"),
+
+					// test datasources
+					tftest.TestCheckResourceAttrPair(resourceVPC, "name", datasourceVPCByID, "name"),
+					tftest.TestCheckResourceAttrPair(resourceVPC, "description", datasourceVPCByID, "description"),
+					tftest.TestCheckResourceAttrPair(resourceVPC, "labels.A", datasourceVPCByID, "labels.A"),
+
+					tftest.TestCheckResourceAttrPair(resourceVPC, "id", datasourceVPCByName, "id"),
+					tftest.TestCheckResourceAttrPair(resourceVPC, "description", datasourceVPCByName, "description"),
+
+					tftest.TestCheckResourceAttrPair(resourceSubnet, "name", datasourceSubnet, "name"),
+					tftest.TestCheckResourceAttrPair(resourceSubnet, "ipv4_block", datasourceSubnet, "ipv4_block"),
+
+					tftest.TestCheckResourceAttrPair(resourceRoute, "destination", datasourceRoute, "destination"),
+					tftest.TestCheckResourceAttrPair(resourceRoute, "target", datasourceRoute, "target"),
+				),
+			},
+
+			// test update (without datasources)
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.vpc_update.tf.tmpl", &testDataSpec),
+				Check: tftest.ComposeAggregateTestCheckFunc(
+					tftest.TestCheckResourceAttr(resourceVPC, "name", testutils.ResourceName(testDataSpec.ID)+"-updated"),
+					tftest.TestCheckResourceAttr(resourceVPC, "description", "description-test-updated"),
+					tftest.TestCheckResourceAttr(resourceVPC, "labels.A", "C"),
+
+					tftest.TestCheckResourceAttr(resourceSubnet, "name", testutils.ResourceName(testDataSpec.ID)+"-updated"),
+					tftest.TestCheckResourceAttr(resourceSubnet, "description", "description-test-updated"),
+				),
+			},
+
+			// Import resources
+			{
+				ResourceName:      resourceVPC,
+				ImportStateIdFunc: importStateIDFunc(resourceVPC),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceSubnet,
+				ImportStateIdFunc: importStateIDFunc(resourceSubnet),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceRoute,
+				ImportStateIdFunc: importStateIDFunc(resourceRoute),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Test_Resource_VPC_Subnet_Attachment(t *testing.T) {
+	t.Parallel()
+
+	resourceSubnet := "exoscale_vpc_subnet.test_subnet"
+	resourceInstanceNIF := "exoscale_compute_instance.test_nif"
+	resourceInstance := "exoscale_compute_instance.test_attachment"
+	resourceAttachment := "exoscale_vpc_subnet_attachment.test_attachment"
+
+	testDataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	tftest.Test(t, tftest.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []tftest.TestStep{
+			// Attach instances through network_interface and attachment resource
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/003.subnet_attachment.tf.tmpl", &testDataSpec),
+				Check: tftest.ComposeAggregateTestCheckFunc(
+					tftest.TestCheckResourceAttr(resourceInstanceNIF, "network_interface.#", "1"),
+					tftest.TestCheckTypeSetElemAttrPair(resourceInstanceNIF, "network_interface.*.subnet_id", resourceSubnet, "id"),
+
+					tftest.TestCheckResourceAttrPair(resourceAttachment, "subnet_id", resourceSubnet, "id"),
+					tftest.TestCheckResourceAttrPair(resourceAttachment, "instance_id", resourceInstance, "id"),
+				),
+			},
+
+			// Import attachment
+			{
+				ResourceName: resourceAttachment,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s@%s", s.RootModule().Resources[resourceAttachment].Primary.ID, testDataSpec.Zone), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
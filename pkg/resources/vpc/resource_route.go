@@ -0,0 +1,358 @@
+package vpc
+
+import (
+	"context"
+	"errors"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/validators"
+)
+
+const markdownDescriptionResourceRoute = `Manage Exoscale [VPC](https://community.exoscale.com/product/networking/vpc/) Routes.
+
+Routes are attached to a [exoscale_vpc_subnet](./vpc_subnet.md) and cannot be updated: any change forces the creation of a new route.
+
+Corresponding data source: [exoscale_vpc_route](../data-sources/vpc_route.md).
+`
+
+var _ resource.ResourceWithImportState = (*ResourceRoute)(nil)
+
+type ResourceRoute struct {
+	client *exoscale.Client
+}
+
+func NewResourceRoute() resource.Resource {
+	return &ResourceRoute{}
+}
+
+// ResourceRouteModel holds the Terraform state for a VPC route.
+type ResourceRouteModel struct {
+	ID          types.String `tfsdk:"id"`
+	Zone        types.String `tfsdk:"zone"`
+	Description types.String `tfsdk:"description"`
+	VPCID       types.String `tfsdk:"vpc_id"`
+	SubnetID    types.String `tfsdk:"subnet_id"`
+	Destination types.String `tfsdk:"destination"`
+	Target      types.String `tfsdk:"target"`
+	Kind        types.String `tfsdk:"kind"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceRoute) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_route"
+}
+
+func (r *ResourceRoute) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manage Exoscale VPC Routes.",
+		MarkdownDescription: markdownDescriptionResourceRoute,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "❗ The Exoscale zone name.",
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description:         "❗ A free-form text describing the route.",
+				MarkdownDescription: "❗ A free-form text describing the route.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(4096),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Description:         "❗ The ID of the VPC the route belongs to.",
+				MarkdownDescription: "❗ The [exoscale_vpc](./vpc.md) (ID) the route belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet_id": schema.StringAttribute{
+				Description:         "❗ The ID of the VPC subnet the route is attached to.",
+				MarkdownDescription: "❗ The [exoscale_vpc_subnet](./vpc_subnet.md) (ID) the route is attached to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Description:         "❗ The route destination network, in CIDR notation (e.g. 0.0.0.0/0).",
+				MarkdownDescription: "❗ The route destination network, in [CIDR](https://en.wikipedia.org/wiki/Classless_Inter-Domain_Routing#CIDR_notation) notation (e.g. `0.0.0.0/0`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.IsCIDRNetworkValidator{Min: 0, Max: 32},
+				},
+			},
+			"target": schema.StringAttribute{
+				Description:         "❗ The route target (next hop).",
+				MarkdownDescription: "❗ The route target (next hop).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description:         "The route kind (Subnet or Vpc).",
+				MarkdownDescription: "The route kind (`Subnet` or `Vpc`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceRoute) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *ResourceRoute) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceRouteModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	vpcID, err := exoscale.ParseUUID(plan.VPCID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse VPC ID", err.Error())
+		return
+	}
+
+	subnetID, err := exoscale.ParseUUID(plan.SubnetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse subnet ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	route, err := client.CreateRoute(ctx, vpcID, subnetID, exoscale.CreateRouteRequest{
+		Description: plan.Description.ValueString(),
+		Destination: plan.Destination.ValueString(),
+		Target:      plan.Target.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when creating VPC route", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(route.ID.String())
+	plan.Kind = types.StringValue(string(route.Kind))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceRoute) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceRouteModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	// Imported routes don't know their VPC and subnet yet.
+	if state.VPCID.IsNull() || state.SubnetID.IsNull() {
+		vpcID, subnetID, err := findRouteSubnet(ctx, client, id)
+		if err != nil {
+			if errors.Is(err, exoscale.ErrNotFound) {
+				tflog.Info(ctx, "VPC route not found, removing from state", map[string]any{})
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("API returned an error while looking up VPC route", err.Error())
+			return
+		}
+
+		state.VPCID = types.StringValue(vpcID.String())
+		state.SubnetID = types.StringValue(subnetID.String())
+	}
+
+	vpcID, err := exoscale.ParseUUID(state.VPCID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse VPC ID", err.Error())
+		return
+	}
+
+	routes, err := client.ListVpcRoutes(ctx, vpcID)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			tflog.Info(ctx, "VPC not found, removing route from state", map[string]any{})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error while listing VPC routes", err.Error())
+		return
+	}
+
+	route, err := routes.FindListRouteEntry(id.String())
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			tflog.Info(ctx, "VPC route not found, removing from state", map[string]any{})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("unable to find VPC route", err.Error())
+		return
+	}
+
+	state.Description = optionalStringValue(route.Description)
+	state.Destination = types.StringValue(route.Destination)
+	state.Target = types.StringValue(route.Target)
+	state.Kind = types.StringValue(string(route.Kind))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is a no-op: all mutable attributes use RequiresReplace.
+func (r *ResourceRoute) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *ResourceRoute) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceRouteModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse ID", err.Error())
+		return
+	}
+
+	vpcID, err := exoscale.ParseUUID(state.VPCID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse VPC ID", err.Error())
+		return
+	}
+
+	subnetID, err := exoscale.ParseUUID(state.SubnetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse subnet ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	if _, err := client.DeleteRoute(ctx, vpcID, subnetID, id); err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error when deleting VPC route", err.Error())
+		return
+	}
+}
+
+func (r *ResourceRoute) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, zone, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var t timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceRouteModel{
+		ID:       types.StringValue(id.String()),
+		Zone:     types.StringValue(zone),
+		VPCID:    types.StringNull(),
+		SubnetID: types.StringNull(),
+		Timeouts: t,
+	})...)
+}
@@ -0,0 +1,454 @@
+package vpc
+
+import (
+	"context"
+	"errors"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/validators"
+)
+
+const markdownDescriptionResourceSubnet = `Manage Exoscale [VPC](https://community.exoscale.com/product/networking/vpc/) Subnets.
+
+Corresponding data source: [exoscale_vpc_subnet](../data-sources/vpc_subnet.md).
+`
+
+var _ resource.ResourceWithImportState = (*ResourceSubnet)(nil)
+
+type ResourceSubnet struct {
+	client *exoscale.Client
+}
+
+func NewResourceSubnet() resource.Resource {
+	return &ResourceSubnet{}
+}
+
+// ResourceSubnetModel holds the Terraform state for a VPC subnet.
+type ResourceSubnetModel struct {
+	ID            types.String `tfsdk:"id"`
+	Zone          types.String `tfsdk:"zone"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	VPCID         types.String `tfsdk:"vpc_id"`
+	IPv4Block     types.String `tfsdk:"ipv4_block"`
+	AddressSpace  types.String `tfsdk:"address_space"`
+	AddressFamily types.String `tfsdk:"address_family"`
+	Labels        types.Map    `tfsdk:"labels"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *ResourceSubnetModel) apply(ctx context.Context, subnet *exoscale.Subnet) diag.Diagnostics {
+	m.ID = types.StringValue(subnet.ID.String())
+	m.Name = types.StringValue(subnet.Name)
+	m.Description = optionalStringValue(subnet.Description)
+	m.IPv4Block = types.StringValue(subnet.Ipv4Block)
+	m.AddressSpace = types.StringValue(string(subnet.AddressSpace))
+	m.AddressFamily = types.StringValue(string(subnet.Addressfamily))
+	m.CreatedAt = types.StringValue(subnet.CreatedAT.String())
+
+	labels, diags := labelsToModel(ctx, subnet.Labels)
+	m.Labels = labels
+
+	return diags
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSubnet) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_subnet"
+}
+
+func (r *ResourceSubnet) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manage Exoscale VPC Subnets.",
+		MarkdownDescription: markdownDescriptionResourceSubnet,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "❗ The Exoscale zone name.",
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The subnet name.",
+				MarkdownDescription: "The subnet name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description:         "A free-form text describing the subnet.",
+				MarkdownDescription: "A free-form text describing the subnet.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(4096),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Description:         "❗ The ID of the VPC the subnet belongs to.",
+				MarkdownDescription: "❗ The [exoscale_vpc](./vpc.md) (ID) the subnet belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipv4_block": schema.StringAttribute{
+				Description:         "The subnet IPv4 network, in CIDR notation (e.g. 10.0.0.0/24). If omitted, a block is allocated by the platform.",
+				MarkdownDescription: "The subnet IPv4 network, in [CIDR](https://en.wikipedia.org/wiki/Classless_Inter-Domain_Routing#CIDR_notation) notation (e.g. `10.0.0.0/24`). If omitted, a block is allocated by the platform.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validators.IsCIDRNetworkValidator{Min: 0, Max: 32},
+				},
+			},
+			"address_space": schema.StringAttribute{
+				Description:         "❗ The subnet address space (default: private).",
+				MarkdownDescription: "❗ The subnet address space (default: `private`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(exoscale.CreateSubnetRequestAddressSpacePrivate)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(exoscale.CreateSubnetRequestAddressSpacePrivate)),
+				},
+			},
+			"address_family": schema.StringAttribute{
+				Description:         "The subnet address family.",
+				MarkdownDescription: "The subnet address family.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description:         "A map of key/value labels.",
+				MarkdownDescription: "A map of key/value labels.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				Description:         "The subnet creation date.",
+				MarkdownDescription: "The subnet creation date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceSubnet) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *ResourceSubnet) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSubnetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	vpcID, err := exoscale.ParseUUID(plan.VPCID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse VPC ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	request := exoscale.CreateSubnetRequest{
+		Name:          plan.Name.ValueString(),
+		Description:   plan.Description.ValueString(),
+		AddressSpace:  exoscale.CreateSubnetRequestAddressSpace(plan.AddressSpace.ValueString()),
+		Addressfamily: exoscale.CreateSubnetRequestAddressfamilyInet4,
+	}
+	if !plan.IPv4Block.IsUnknown() {
+		request.Ipv4Block = plan.IPv4Block.ValueString()
+	}
+	if len(plan.Labels.Elements()) > 0 {
+		labels, dg := labelsFromModel(ctx, plan.Labels)
+		if dg.HasError() {
+			resp.Diagnostics.Append(dg...)
+			return
+		}
+
+		request.Labels = labels
+	}
+
+	op, err := client.CreateSubnet(ctx, vpcID, request)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when creating VPC subnet", err.Error())
+		return
+	}
+
+	op, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError("create VPC subnet operation failed", err.Error())
+		return
+	}
+
+	subnet, err := client.GetSubnet(ctx, vpcID, op.Reference.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error while fetching VPC subnet", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(subnet.ID.String())
+	plan.IPv4Block = types.StringValue(subnet.Ipv4Block)
+	plan.AddressFamily = types.StringValue(string(subnet.Addressfamily))
+	plan.CreatedAt = types.StringValue(subnet.CreatedAT.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceSubnet) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSubnetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	// Imported subnets don't know their VPC yet.
+	if state.VPCID.IsNull() {
+		vpcID, err := findSubnetVPC(ctx, client, id)
+		if err != nil {
+			if errors.Is(err, exoscale.ErrNotFound) {
+				tflog.Info(ctx, "VPC subnet not found, removing from state", map[string]any{})
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("API returned an error while looking up VPC subnet", err.Error())
+			return
+		}
+
+		state.VPCID = types.StringValue(vpcID.String())
+	}
+
+	vpcID, err := exoscale.ParseUUID(state.VPCID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse VPC ID", err.Error())
+		return
+	}
+
+	subnet, err := client.GetSubnet(ctx, vpcID, id)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			tflog.Info(ctx, "VPC subnet not found, removing from state", map[string]any{})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error while fetching VPC subnet", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.apply(ctx, subnet)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceSubnet) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ResourceSubnetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := exoscale.ParseUUID(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse ID", err.Error())
+		return
+	}
+
+	vpcID, err := exoscale.ParseUUID(plan.VPCID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse VPC ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	labels, dg := labelsFromModel(ctx, plan.Labels)
+	if dg.HasError() {
+		resp.Diagnostics.Append(dg...)
+		return
+	}
+
+	request := exoscale.UpdateSubnetRequest{
+		Name:        plan.Name.ValueStringPointer(),
+		Description: exoscale.Ptr(plan.Description.ValueString()),
+		Labels:      labels,
+	}
+	if !plan.IPv4Block.IsUnknown() && !plan.IPv4Block.Equal(state.IPv4Block) {
+		request.Ipv4Block = plan.IPv4Block.ValueStringPointer()
+	}
+
+	subnet, err := client.UpdateSubnet(ctx, vpcID, id, request)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when updating VPC subnet", err.Error())
+		return
+	}
+
+	plan.IPv4Block = types.StringValue(subnet.Ipv4Block)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceSubnet) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSubnetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse ID", err.Error())
+		return
+	}
+
+	vpcID, err := exoscale.ParseUUID(state.VPCID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse VPC ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	if _, err := client.DeleteSubnet(ctx, vpcID, id); err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error when deleting VPC subnet", err.Error())
+		return
+	}
+}
+
+func (r *ResourceSubnet) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, zone, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var t timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceSubnetModel{
+		ID:       types.StringValue(id.String()),
+		Zone:     types.StringValue(zone),
+		VPCID:    types.StringNull(),
+		Labels:   types.MapNull(types.StringType),
+		Timeouts: t,
+	})...)
+}
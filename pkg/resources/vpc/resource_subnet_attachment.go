@@ -0,0 +1,346 @@
+package vpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionResourceSubnetAttachment = `Attach an Exoscale [Compute Instance](./compute_instance.md) to a [VPC Subnet](./vpc_subnet.md).
+
+This resource allows managing the attachment independently from the instance, e.g. from a different module.
+Alternatively, subnets can be attached with the ` + "`network_interface`" + ` block of the [exoscale_compute_instance](./compute_instance.md) resource; both methods must not be used for the same subnet and instance.
+
+!> **WARNING:** the API doesn't report subnet attachments back, so an attachment removed outside of Terraform is not detected.
+`
+
+var _ resource.ResourceWithImportState = (*ResourceSubnetAttachment)(nil)
+
+type ResourceSubnetAttachment struct {
+	client *exoscale.Client
+}
+
+func NewResourceSubnetAttachment() resource.Resource {
+	return &ResourceSubnetAttachment{}
+}
+
+// ResourceSubnetAttachmentModel holds the Terraform state for a VPC subnet attachment.
+type ResourceSubnetAttachmentModel struct {
+	ID         types.String `tfsdk:"id"`
+	Zone       types.String `tfsdk:"zone"`
+	VPCID      types.String `tfsdk:"vpc_id"`
+	SubnetID   types.String `tfsdk:"subnet_id"`
+	InstanceID types.String `tfsdk:"instance_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSubnetAttachment) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_subnet_attachment"
+}
+
+func (r *ResourceSubnetAttachment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Attach an Exoscale Compute Instance to a VPC Subnet.",
+		MarkdownDescription: markdownDescriptionResourceSubnetAttachment,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource (`<subnet-ID>/<instance-ID>`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "❗ The Exoscale zone name.",
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Description:         "❗ The ID of the VPC the subnet belongs to.",
+				MarkdownDescription: "❗ The [exoscale_vpc](./vpc.md) (ID) the subnet belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet_id": schema.StringAttribute{
+				Description:         "❗ The ID of the VPC subnet to attach the instance to.",
+				MarkdownDescription: "❗ The [exoscale_vpc_subnet](./vpc_subnet.md) (ID) to attach the instance to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description:         "❗ The ID of the compute instance to attach.",
+				MarkdownDescription: "❗ The [exoscale_compute_instance](./compute_instance.md) (ID) to attach.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ResourceSubnetAttachment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *ResourceSubnetAttachment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSubnetAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	vpcID, subnetID, instanceID, err := plan.parseIDs()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse IDs", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	op, err := client.AttachInstanceToSubnet(ctx, vpcID, subnetID, exoscale.AttachInstanceToSubnetRequest{
+		Instance: &exoscale.InstanceRef{ID: instanceID},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when attaching instance to VPC subnet", err.Error())
+		return
+	}
+
+	if _, err = client.Wait(ctx, op, exoscale.OperationStateSuccess); err != nil {
+		resp.Diagnostics.AddError("API returned an error when attaching instance to VPC subnet", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", subnetID, instanceID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceSubnetAttachment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSubnetAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	// Imported attachments don't know their VPC yet.
+	if state.VPCID.IsNull() {
+		subnetID, err := exoscale.ParseUUID(state.SubnetID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("unable to parse subnet ID", err.Error())
+			return
+		}
+
+		vpcID, err := findSubnetVPC(ctx, client, subnetID)
+		if err != nil {
+			if errors.Is(err, exoscale.ErrNotFound) {
+				tflog.Info(ctx, "VPC subnet not found, removing attachment from state", map[string]any{})
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("API returned an error while looking up VPC subnet", err.Error())
+			return
+		}
+		state.VPCID = types.StringValue(vpcID.String())
+	}
+
+	vpcID, subnetID, instanceID, err := state.parseIDs()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse IDs", err.Error())
+		return
+	}
+
+	// The API doesn't expose subnet attachments: only check that both ends still exist.
+	if _, err := client.GetSubnet(ctx, vpcID, subnetID); err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			tflog.Info(ctx, "VPC subnet not found, removing attachment from state", map[string]any{})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error while reading VPC subnet", err.Error())
+		return
+	}
+
+	if _, err := client.GetInstance(ctx, instanceID); err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			tflog.Info(ctx, "Compute instance not found, removing attachment from state", map[string]any{})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error while reading compute instance", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is a no-op: all attributes use RequiresReplace.
+func (r *ResourceSubnetAttachment) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *ResourceSubnetAttachment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSubnetAttachmentModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	vpcID, subnetID, instanceID, err := state.parseIDs()
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse IDs", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	op, err := client.DetachInstanceFromSubnet(ctx, vpcID, subnetID, exoscale.DetachInstanceFromSubnetRequest{
+		Instance: &exoscale.InstanceRef{ID: instanceID},
+	})
+	if err == nil {
+		_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	}
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error when detaching instance from VPC subnet", err.Error())
+		return
+	}
+}
+
+func (r *ResourceSubnetAttachment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+	if len(idParts) != 2 || !slices.Contains(config.Zones, idParts[1]) {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("expected import identifier with format: subnet_id/instance_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	ids := strings.Split(idParts[0], "/")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("expected import identifier with format: subnet_id/instance_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var t timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceSubnetAttachmentModel{
+		ID:         types.StringValue(idParts[0]),
+		Zone:       types.StringValue(idParts[1]),
+		VPCID:      types.StringNull(),
+		SubnetID:   types.StringValue(ids[0]),
+		InstanceID: types.StringValue(ids[1]),
+		Timeouts:   t,
+	})...)
+}
+
+func (m *ResourceSubnetAttachmentModel) parseIDs() (exoscale.UUID, exoscale.UUID, exoscale.UUID, error) {
+	vpcID, err := exoscale.ParseUUID(m.VPCID.ValueString())
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid VPC ID: %w", err)
+	}
+
+	subnetID, err := exoscale.ParseUUID(m.SubnetID.ValueString())
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid subnet ID: %w", err)
+	}
+
+	instanceID, err := exoscale.ParseUUID(m.InstanceID.ValueString())
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid instance ID: %w", err)
+	}
+
+	return vpcID, subnetID, instanceID, nil
+}
@@ -0,0 +1,350 @@
+package vpc
+
+import (
+	"context"
+	"errors"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const markdownDescriptionResourceVPC = `Manage Exoscale [Virtual Private Clouds](https://community.exoscale.com/product/networking/vpc/) (VPC).
+
+Subnets and routes of a VPC are managed with the [exoscale_vpc_subnet](./vpc_subnet.md) and [exoscale_vpc_route](./vpc_route.md) resources.
+
+Corresponding data source: [exoscale_vpc](../data-sources/vpc.md).
+`
+
+var _ resource.ResourceWithImportState = (*ResourceVPC)(nil)
+
+type ResourceVPC struct {
+	client *exoscale.Client
+}
+
+func NewResourceVPC() resource.Resource {
+	return &ResourceVPC{}
+}
+
+// ResourceVPCModel holds the Terraform state for a VPC.
+type ResourceVPCModel struct {
+	ID          types.String `tfsdk:"id"`
+	Zone        types.String `tfsdk:"zone"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Labels      types.Map    `tfsdk:"labels"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *ResourceVPCModel) apply(ctx context.Context, vpc *exoscale.Vpc) diag.Diagnostics {
+	m.ID = types.StringValue(vpc.ID.String())
+	m.Name = types.StringValue(vpc.Name)
+	m.Description = optionalStringValue(vpc.Description)
+	m.CreatedAt = types.StringValue(vpc.CreatedAT.String())
+
+	labels, diags := labelsToModel(ctx, vpc.Labels)
+	m.Labels = labels
+
+	return diags
+}
+
+// Metadata specifies resource name.
+func (r *ResourceVPC) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc"
+}
+
+func (r *ResourceVPC) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manage Exoscale Virtual Private Clouds (VPC).",
+		MarkdownDescription: markdownDescriptionResourceVPC,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "❗ The Exoscale zone name.",
+				MarkdownDescription: "❗ The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "The VPC name.",
+				MarkdownDescription: "The VPC name.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description:         "A free-form text describing the VPC.",
+				MarkdownDescription: "A free-form text describing the VPC.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(4096),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description:         "A map of key/value labels.",
+				MarkdownDescription: "A map of key/value labels.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				Description:         "The VPC creation date.",
+				MarkdownDescription: "The VPC creation date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ResourceVPC) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+func (r *ResourceVPC) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceVPCModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	request := exoscale.CreateVpcRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+	if len(plan.Labels.Elements()) > 0 {
+		labels, dg := labelsFromModel(ctx, plan.Labels)
+		if dg.HasError() {
+			resp.Diagnostics.Append(dg...)
+			return
+		}
+
+		request.Labels = labels
+	}
+
+	op, err := client.CreateVpc(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when creating VPC", err.Error())
+		return
+	}
+
+	op, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError("create VPC operation failed", err.Error())
+		return
+	}
+
+	vpc, err := client.GetVpc(ctx, op.Reference.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error while fetching VPC", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(vpc.ID.String())
+	plan.CreatedAt = types.StringValue(vpc.CreatedAT.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceVPC) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceVPCModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	vpc, err := client.GetVpc(ctx, id)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			tflog.Info(ctx, "VPC not found, removing from state", map[string]any{})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error while fetching VPC", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.apply(ctx, vpc)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceVPC) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ResourceVPCModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := exoscale.ParseUUID(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(plan.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	labels, dg := labelsFromModel(ctx, plan.Labels)
+	if dg.HasError() {
+		resp.Diagnostics.Append(dg...)
+		return
+	}
+
+	_, err = client.UpdateVpc(ctx, id, exoscale.UpdateVpcRequest{
+		Name:        plan.Name.ValueStringPointer(),
+		Description: exoscale.Ptr(plan.Description.ValueString()),
+		Labels:      labels,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API returned an error when updating VPC", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceVPC) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceVPCModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to parse ID", err.Error())
+		return
+	}
+
+	client, err := utils.SwitchClientZone(ctx, r.client, exoscale.ZoneName(state.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to change exoscale client zone", err.Error())
+		return
+	}
+
+	if _, err := client.DeleteVpc(ctx, id); err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("API returned an error when deleting VPC", err.Error())
+		return
+	}
+}
+
+func (r *ResourceVPC) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, zone, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var t timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ResourceVPCModel{
+		ID:       types.StringValue(id.String()),
+		Zone:     types.StringValue(zone),
+		Labels:   types.MapNull(types.StringType),
+		Timeouts: t,
+	})...)
+}
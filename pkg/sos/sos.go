@@ -2,17 +2,44 @@ package sos
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	awscredentials "github.com/aws/aws-sdk-go-v2/credentials"
 )
 
-func NewSOSClient(ctx context.Context, zone, sosEndpoint, exoAPIKey, exoAPISecret string) (*s3.Client, error) {
+// Endpoint returns the SOS endpoint to use in the given zone,
+// sosEndpoint overrides the default zone endpoint when set.
+func Endpoint(zone, sosEndpoint string) string {
 	if sosEndpoint == "" {
-		sosEndpoint = "https://sos-" + zone + ".exo.io"
+		return "https://sos-" + zone + ".exo.io"
+	}
+
+	return sosEndpoint
+}
+
+// HasErrorCode reports whether err is an error returned by SOS with one of the given codes.
+func HasErrorCode(err error, codes ...string) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
 	}
+
+	return slices.Contains(codes, apiErr.ErrorCode())
+}
+
+// IsNotFound reports whether err is a not found error returned by SOS,
+// either for a bucket or an object.
+func IsNotFound(err error) bool {
+	return HasErrorCode(err, "NotFound", "NoSuchBucket", "NoSuchKey")
+}
+
+func NewSOSClient(ctx context.Context, zone, sosEndpoint, exoAPIKey, exoAPISecret string) (*s3.Client, error) {
+	sosEndpoint = Endpoint(zone, sosEndpoint)
 	cfg, err := awsconfig.LoadDefaultConfig(
 		ctx,
 		awsconfig.WithRegion(zone),
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	egoscale "github.com/exoscale/egoscale/v2"
@@ -163,3 +164,11 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 		return tf6muxserver.NewMuxServer(ctx, providers...)
 	},
 }
+
+// TestAccProtoV6ProviderFactoriesWithEcho adds the "echo" test provider to
+// TestAccProtoV6ProviderFactories, ephemeral resource values can be fed to an
+// echo resource in order to be checked in state.
+var TestAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"exoscale": TestAccProtoV6ProviderFactories["exoscale"],
+	"echo":     echoprovider.NewProviderServer(),
+}
@@ -5,10 +5,12 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -285,6 +287,53 @@ func SwitchClientZone(ctx context.Context, client *exov3.Client, zone exov3.Zone
 	return client.WithEndpoint(endpoint), nil
 }
 
+// WithJSONBodyFields clones the existing exoscale Client so that the given top-level fields
+// are set in the JSON body of its requests. It allows sending values that the API request
+// types would omit, such as empty lists clearing a remote setting.
+func WithJSONBodyFields(client *exov3.Client, fields map[string]any) *exov3.Client {
+	return client.WithRequestInterceptor(func(_ context.Context, req *http.Request) error {
+		return setJSONBodyFields(req, fields)
+	})
+}
+
+// setJSONBodyFields sets the given top-level fields in the JSON body of the request.
+func setJSONBodyFields(req *http.Request, fields map[string]any) error {
+	body := map[string]any{}
+
+	if req.Body != nil {
+		raw, err := io.ReadAll(req.Body)
+		if err != nil {
+			return fmt.Errorf("read request body: %w", err)
+		}
+		if err := req.Body.Close(); err != nil {
+			return fmt.Errorf("close request body: %w", err)
+		}
+
+		if len(bytes.TrimSpace(raw)) > 0 {
+			if err := json.Unmarshal(raw, &body); err != nil {
+				return fmt.Errorf("decode request body: %w", err)
+			}
+		}
+	}
+
+	for k, v := range fields {
+		body[k] = v
+	}
+
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encode request body: %w", err)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+	req.ContentLength = int64(len(raw))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(raw)), nil
+	}
+
+	return nil
+}
+
 // FindInstanceTypeByName copies the behaviour of egoscale v2's client.FindInstanceType
 // but using the v3 api:
 // FindInstanceType attempts to find an Instance type by family+size or ID.
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -40,3 +43,29 @@ func TestSuppressUserDataDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestSetJSONBodyFields(t *testing.T) {
+	t.Parallel()
+
+	req, err := http.NewRequest(http.MethodPut, "https://example.net", strings.NewReader(`{"name":"test","options":{"routers":["10.0.0.1"]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := setJSONBodyFields(req, map[string]any{"options": map[string]any{"routers": []string{}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const expected = `{"name":"test","options":{"routers":[]}}`
+	if string(raw) != expected {
+		t.Errorf("expected body %s, got %s", expected, raw)
+	}
+	if req.ContentLength != int64(len(expected)) {
+		t.Errorf("expected content length %d, got %d", len(expected), req.ContentLength)
+	}
+}